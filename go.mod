@@ -5,6 +5,7 @@ go 1.16
 require (
 	github.com/agext/levenshtein v1.2.3 // indirect
 	github.com/google/uuid v1.1.2
+	github.com/hashicorp/go-cty v1.4.1-0.20200414143053-d3edf31b6320
 	github.com/hashicorp/go-multierror v1.1.0 // indirect
 	github.com/hashicorp/go-version v1.3.0
 	github.com/hashicorp/hcl/v2 v2.6.0 // indirect
@@ -16,5 +17,6 @@ require (
 	github.com/vmware/vsphere-automation-sdk-go/services/nsxt v0.5.0
 	github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm v0.3.0
 	golang.org/x/mod v0.4.0 // indirect
+	golang.org/x/net v0.0.0-20210326060303-6b1517762897
 	golang.org/x/tools v0.1.0 // indirect
 )
@@ -15,4 +15,7 @@ func main() {
 			return nsxt.Provider()
 		},
 	})
+
+	// Release provider resources once terraform is done with the plugin
+	nsxt.Shutdown()
 }
@@ -0,0 +1,426 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const apiRedactedValue = "REDACTED"
+
+// Bodies are truncated to this size in logs and HAR file
+const apiLogMaxBodySize = 64 * 1024
+
+// Closes JSON document of HAR file after the last entry
+const apiHARTrailer = "\n]}}\n"
+
+// Distinguishes HAR files of provider instances within the same process
+var apiHARFileCount int32
+
+// Attributes with secret values, such as passwords, pre-shared keys,
+// private keys and tokens
+var apiSecretKeyRegexp = regexp.MustCompile(`(?i)(password|passwd|passphrase|secret|psk|pre_shared_key|private_key|token)`)
+var apiPrivateKeyRegexp = regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`)
+var apiSecretHeaders = []string{"Authorization", "Cookie", "Set-Cookie", nsxtSessionXsrfHeader, "Csp-Auth-Token"}
+
+// Logs NSX API calls of both MP and policy clients when enabled in provider
+// configuration. Since policy request processors have no access to the
+// response, logging is performed on transport level. Summary of each call is
+// logged with DEBUG level, and bodies with TRACE level. Optionally, calls are
+// recorded in HAR file. Each provider instance writes its own file, and
+// entries are written as they arrive, so that the file is a valid HAR
+// document at any time.
+type apiLogger struct {
+	logEnabled    bool
+	harFile       string
+	secretHeaders []string
+	mutex         sync.Mutex
+	harWriter     *os.File
+	harOffset     int64
+	harEntries    int
+	harDisabled   bool
+}
+
+type apiHARNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type apiHARPostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+type apiHARRequest struct {
+	Method      string            `json:"method"`
+	URL         string            `json:"url"`
+	HTTPVersion string            `json:"httpVersion"`
+	Headers     []apiHARNameValue `json:"headers"`
+	QueryString []apiHARNameValue `json:"queryString"`
+	Cookies     []apiHARNameValue `json:"cookies"`
+	PostData    *apiHARPostData   `json:"postData,omitempty"`
+	HeadersSize int               `json:"headersSize"`
+	BodySize    int               `json:"bodySize"`
+}
+
+type apiHARContent struct {
+	Size     int    `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+type apiHARResponse struct {
+	Status      int               `json:"status"`
+	StatusText  string            `json:"statusText"`
+	HTTPVersion string            `json:"httpVersion"`
+	Headers     []apiHARNameValue `json:"headers"`
+	Cookies     []apiHARNameValue `json:"cookies"`
+	Content     apiHARContent     `json:"content"`
+	RedirectURL string            `json:"redirectURL"`
+	HeadersSize int               `json:"headersSize"`
+	BodySize    int               `json:"bodySize"`
+}
+
+type apiHARTimings struct {
+	Send    int64 `json:"send"`
+	Wait    int64 `json:"wait"`
+	Receive int64 `json:"receive"`
+}
+
+type apiHAREntry struct {
+	StartedDateTime string         `json:"startedDateTime"`
+	Time            int64          `json:"time"`
+	Request         apiHARRequest  `json:"request"`
+	Response        apiHARResponse `json:"response"`
+	Cache           struct{}       `json:"cache"`
+	Timings         apiHARTimings  `json:"timings"`
+}
+
+// Headers from provider configuration are redacted along with known
+// authentication headers, since they often carry credentials for proxies
+func newAPILogger(logEnabled bool, harFile string, customHeaders []string) *apiLogger {
+	if !logEnabled && harFile == "" {
+		return nil
+	}
+
+	logger := &apiLogger{
+		logEnabled:    logEnabled,
+		secretHeaders: append(append([]string{}, apiSecretHeaders...), customHeaders...),
+	}
+	if harFile != "" {
+		logger.harFile = getAPIHARFileName(harFile, os.Getpid(), int(atomic.AddInt32(&apiHARFileCount, 1)))
+		registerShutdownHook(logger.closeHARFile)
+	}
+
+	return logger
+}
+
+// Inserts process ID and provider instance number before file extension,
+// since several provider processes and aliased instances may record calls
+// at the same time
+func getAPIHARFileName(harFile string, pid int, instance int) string {
+	ext := filepath.Ext(harFile)
+	return fmt.Sprintf("%s-%d-%d%s", strings.TrimSuffix(harFile, ext), pid, instance, ext)
+}
+
+func redactAPIValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, elem := range v {
+			if apiSecretKeyRegexp.MatchString(key) {
+				v[key] = apiRedactedValue
+			} else {
+				v[key] = redactAPIValue(elem)
+			}
+		}
+	case []interface{}:
+		for i, elem := range v {
+			v[i] = redactAPIValue(elem)
+		}
+	case string:
+		if apiPrivateKeyRegexp.MatchString(v) {
+			return apiRedactedValue
+		}
+	}
+	return value
+}
+
+func redactAPIBody(contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var obj interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&obj); err == nil {
+		var redacted bytes.Buffer
+		encoder := json.NewEncoder(&redacted)
+		encoder.SetEscapeHTML(false)
+		if err := encoder.Encode(redactAPIValue(obj)); err == nil {
+			return strings.TrimSpace(redacted.String())
+		}
+	}
+
+	if strings.Contains(contentType, "application/x-www-form-urlencoded") {
+		if values, err := url.ParseQuery(string(body)); err == nil {
+			for key := range values {
+				if apiSecretKeyRegexp.MatchString(key) {
+					values.Set(key, apiRedactedValue)
+				}
+			}
+			return values.Encode()
+		}
+	}
+
+	return apiPrivateKeyRegexp.ReplaceAllString(string(body), apiRedactedValue)
+}
+
+func truncateAPIBody(body string) string {
+	if len(body) <= apiLogMaxBodySize {
+		return body
+	}
+	return fmt.Sprintf("%s... (truncated, %d bytes total)", body[:apiLogMaxBodySize], len(body))
+}
+
+func redactAPIQuery(query url.Values) url.Values {
+	redacted := make(url.Values)
+	for key, values := range query {
+		for _, value := range values {
+			if apiSecretKeyRegexp.MatchString(key) {
+				value = apiRedactedValue
+			}
+			redacted.Add(key, value)
+		}
+	}
+	return redacted
+}
+
+// Returns URL with secret query parameters redacted
+func redactAPIURL(u *url.URL) string {
+	if u.RawQuery == "" {
+		return u.String()
+	}
+	redacted := *u
+	redacted.RawQuery = redactAPIQuery(u.Query()).Encode()
+	return redacted.String()
+}
+
+func (l *apiLogger) getHARHeaders(header http.Header) []apiHARNameValue {
+	headers := []apiHARNameValue{}
+	for name, values := range header {
+		secret := false
+		for _, secretHeader := range l.secretHeaders {
+			if strings.EqualFold(name, secretHeader) {
+				secret = true
+			}
+		}
+		for _, value := range values {
+			if secret {
+				value = apiRedactedValue
+			}
+			headers = append(headers, apiHARNameValue{Name: name, Value: value})
+		}
+	}
+	return headers
+}
+
+func getAPIRequestID(resp *http.Response) string {
+	requestID := resp.Header.Get("X-Nsx-Requestid")
+	if requestID == "" {
+		requestID = resp.Header.Get("X-Request-Id")
+	}
+	return requestID
+}
+
+func (l *apiLogger) addHAREntry(req *http.Request, reqBody string, resp *http.Response, respBody string, start time.Time, elapsed time.Duration) {
+	queryString := []apiHARNameValue{}
+	for name, values := range redactAPIQuery(req.URL.Query()) {
+		for _, value := range values {
+			queryString = append(queryString, apiHARNameValue{Name: name, Value: value})
+		}
+	}
+
+	entry := apiHAREntry{
+		StartedDateTime: start.Format(time.RFC3339Nano),
+		Time:            elapsed.Milliseconds(),
+		Request: apiHARRequest{
+			Method:      req.Method,
+			URL:         redactAPIURL(req.URL),
+			HTTPVersion: req.Proto,
+			Headers:     l.getHARHeaders(req.Header),
+			QueryString: queryString,
+			Cookies:     []apiHARNameValue{},
+			HeadersSize: -1,
+			BodySize:    len(reqBody),
+		},
+		Response: apiHARResponse{
+			Status:      resp.StatusCode,
+			StatusText:  http.StatusText(resp.StatusCode),
+			HTTPVersion: resp.Proto,
+			Headers:     l.getHARHeaders(resp.Header),
+			Cookies:     []apiHARNameValue{},
+			Content: apiHARContent{
+				Size:     len(respBody),
+				MimeType: resp.Header.Get("Content-Type"),
+				Text:     respBody,
+			},
+			HeadersSize: -1,
+			BodySize:    len(respBody),
+		},
+		Timings: apiHARTimings{
+			Send:    0,
+			Wait:    elapsed.Milliseconds(),
+			Receive: 0,
+		},
+	}
+	if reqBody != "" {
+		entry.Request.PostData = &apiHARPostData{
+			MimeType: req.Header.Get("Content-Type"),
+			Text:     reqBody,
+		}
+	}
+
+	l.writeHAREntry(entry)
+}
+
+// Should be called with mutex locked
+func (l *apiLogger) openHARFileLocked() error {
+	file, err := os.OpenFile(l.harFile, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+
+	header := `{"log": {"version": "1.2", "creator": {"name": "terraform-provider-nsxt", "version": "1.0"}, "entries": [`
+	_, err = file.WriteString(header + apiHARTrailer)
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	l.harWriter = file
+	l.harOffset = int64(len(header))
+	return nil
+}
+
+// Writes entry in place of the trailer, followed by the trailer
+func (l *apiLogger) writeHAREntry(entry apiHAREntry) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.harDisabled {
+		return
+	}
+
+	if l.harWriter == nil {
+		err := l.openHARFileLocked()
+		if err != nil {
+			log.Printf("[WARNING] Failed to create HAR file %s: %v", l.harFile, err)
+			l.harDisabled = true
+			return
+		}
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		log.Printf("[WARNING] Failed to encode HAR entry: %v", err)
+		return
+	}
+	separator := "\n"
+	if l.harEntries > 0 {
+		separator = ",\n"
+	}
+	data = append([]byte(separator), data...)
+
+	_, err = l.harWriter.WriteAt(append(data, apiHARTrailer...), l.harOffset)
+	if err != nil {
+		log.Printf("[WARNING] Failed to write HAR file %s: %v", l.harFile, err)
+		l.harDisabled = true
+		return
+	}
+	l.harOffset += int64(len(data))
+	l.harEntries++
+}
+
+func (l *apiLogger) closeHARFile() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.harWriter == nil {
+		return
+	}
+
+	l.harWriter.Close()
+	l.harWriter = nil
+	l.harDisabled = true
+	log.Printf("[DEBUG] Recorded %d NSX API calls in HAR file %s", l.harEntries, l.harFile)
+}
+
+type apiLoggingTransport struct {
+	transport http.RoundTripper
+	logger    *apiLogger
+}
+
+func newAPILoggingTransport(transport http.RoundTripper, logger *apiLogger) *apiLoggingTransport {
+	return &apiLoggingTransport{transport: transport, logger: logger}
+}
+
+func (t *apiLoggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var reqBody string
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			data, _ := ioutil.ReadAll(body)
+			body.Close()
+			reqBody = truncateAPIBody(redactAPIBody(req.Header.Get("Content-Type"), data))
+		}
+	}
+
+	reqURL := redactAPIURL(req.URL)
+	start := time.Now()
+	resp, err := t.transport.RoundTrip(req)
+	elapsed := time.Since(start)
+	if err != nil {
+		if t.logger.logEnabled {
+			log.Printf("[DEBUG] NSX API %s %s failed in %v: %v", req.Method, reqURL, elapsed.Round(time.Millisecond), err)
+		}
+		return resp, err
+	}
+
+	data, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(data))
+	respBody := truncateAPIBody(redactAPIBody(resp.Header.Get("Content-Type"), data))
+
+	if t.logger.logEnabled {
+		log.Printf("[DEBUG] NSX API %s %s: %s in %v (request ID %s)", req.Method, reqURL, resp.Status, elapsed.Round(time.Millisecond), getAPIRequestID(resp))
+		if reqBody != "" {
+			log.Printf("[TRACE] NSX API %s %s request body: %s", req.Method, reqURL, reqBody)
+		}
+		if respBody != "" {
+			log.Printf("[TRACE] NSX API %s %s response body: %s", req.Method, reqURL, respBody)
+		}
+	}
+
+	if t.logger.harFile != "" {
+		t.logger.addHAREntry(req, reqBody, resp, respBody, start, elapsed)
+	}
+
+	return resp, nil
+}
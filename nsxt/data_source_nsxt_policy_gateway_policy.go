@@ -105,7 +105,7 @@ func dataSourceNsxtPolicyGatewayPolicyRead(d *schema.ResourceData, m interface{}
 		}
 
 		if err != nil {
-			return fmt.Errorf("Error while reading Gateway Policy %s: %v", objID, err)
+			return fmt.Errorf("Error while reading Gateway Policy %s: %w", objID, err)
 		}
 		obj = objGet
 	} else if objName == "" && category == "" {
@@ -113,7 +113,7 @@ func dataSourceNsxtPolicyGatewayPolicyRead(d *schema.ResourceData, m interface{}
 	} else {
 		objList, err := listGatewayPolicies(domain, connector)
 		if err != nil {
-			return fmt.Errorf("Error while reading Gateway Policies: %v", err)
+			return fmt.Errorf("Error while reading Gateway Policies: %w", err)
 		}
 		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
 		var perfectMatch []model.GatewayPolicy
@@ -0,0 +1,19 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNsxtPolicyGroups() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNsxtPolicyGroupsRead,
+		Schema: getPolicyDataSourceListSchema(nil),
+	}
+}
+
+func dataSourceNsxtPolicyGroupsRead(d *schema.ResourceData, m interface{}) error {
+	return policyDataSourceResourceListRead(d, getPolicyConnector(m), isPolicyGlobalManager(m), "Group", nil)
+}
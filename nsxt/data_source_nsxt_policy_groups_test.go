@@ -0,0 +1,52 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceNsxtPolicyGroups_basic(t *testing.T) {
+	name := getAccTestDataSourceName()
+	domain := "default"
+	testResourceName := "data.nsxt_policy_groups.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccDataSourceNsxtPolicyGroupDeleteByName(domain, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if err := testAccDataSourceNsxtPolicyGroupCreate(domain, name); err != nil {
+						panic(err)
+					}
+				},
+				Config: testAccNsxtPolicyGroupsReadTemplate(domain, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "paths.%", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "ids.%", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "items.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "items.0.display_name", name),
+					resource.TestCheckResourceAttrPair(testResourceName, "items.0.id", testResourceName, fmt.Sprintf("ids.%s", name)),
+					resource.TestCheckResourceAttrPair(testResourceName, "items.0.path", testResourceName, fmt.Sprintf("paths.%s", name)),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyGroupsReadTemplate(domain string, name string) string {
+	return testAccAdjustPolicyInfraConfig(fmt.Sprintf(`
+data "nsxt_policy_groups" "test" {
+  parent_path        = "/infra/domains/%s"
+  display_name_regex = "^%s$"
+}`, domain, name))
+}
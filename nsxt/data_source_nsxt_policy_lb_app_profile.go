@@ -80,7 +80,7 @@ func dataSourceNsxtPolicyLBAppProfileRead(d *schema.ResourceData, m interface{})
 		}
 		result, err = policyLbAppProfileConvert(objGet, objType)
 		if err != nil {
-			return fmt.Errorf("Error while converting LBAppProfile %s: %v", objID, err)
+			return fmt.Errorf("Error while converting LBAppProfile %s: %w", objID, err)
 		}
 		if result == nil {
 			return fmt.Errorf("LBAppProfile with ID '%s' and type %s was not found", objID, objType)
@@ -100,7 +100,7 @@ func dataSourceNsxtPolicyLBAppProfileRead(d *schema.ResourceData, m interface{})
 		for _, objInList := range objList.Results {
 			obj, err := policyLbAppProfileConvert(objInList, objType)
 			if err != nil {
-				return fmt.Errorf("Error while converting LBAppProfile %s: %v", objID, err)
+				return fmt.Errorf("Error while converting LBAppProfile %s: %w", objID, err)
 			}
 			if obj == nil {
 				continue
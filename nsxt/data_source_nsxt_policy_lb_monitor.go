@@ -83,7 +83,7 @@ func dataSourceNsxtPolicyLBMonitorRead(d *schema.ResourceData, m interface{}) er
 		}
 		result, err = policyLbMonitorConvert(objGet, objType)
 		if err != nil {
-			return fmt.Errorf("Error while converting LBMonitor %s: %v", objID, err)
+			return fmt.Errorf("Error while converting LBMonitor %s: %w", objID, err)
 		}
 		if result == nil {
 			return fmt.Errorf("LBMonitor with ID '%s' and type %s was not found", objID, objType)
@@ -103,7 +103,7 @@ func dataSourceNsxtPolicyLBMonitorRead(d *schema.ResourceData, m interface{}) er
 		for _, objInList := range objList.Results {
 			obj, err := policyLbMonitorConvert(objInList, objType)
 			if err != nil {
-				return fmt.Errorf("Error while converting LBMonitor %s: %v", objID, err)
+				return fmt.Errorf("Error while converting LBMonitor %s: %w", objID, err)
 			}
 			if obj == nil {
 				continue
@@ -0,0 +1,190 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyLBNodeUsage() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyLBNodeUsageRead,
+
+		Schema: map[string]*schema.Schema{
+			"id":                     getDataSourceIDSchema(),
+			"enforcement_point_path": getPolicySegmentRuntimeEnforcementPointSchema(),
+			"severity": {
+				Type:        schema.TypeString,
+				Description: "Severity calculated from usage percentage",
+				Computed:    true,
+			},
+			"usage_percentage": {
+				Type:        schema.TypeFloat,
+				Description: "The highest usage percentage among load balancer credits and pool members",
+				Computed:    true,
+			},
+			"current_load_balancer_credits": {
+				Type:        schema.TypeInt,
+				Description: "Number of load balancer credits consumed on all edge nodes",
+				Computed:    true,
+			},
+			"load_balancer_credit_capacity": {
+				Type:        schema.TypeInt,
+				Description: "Number of load balancer credits available on all edge nodes",
+				Computed:    true,
+			},
+			"current_pool_member_count": {
+				Type:        schema.TypeInt,
+				Description: "Number of pool members configured on all edge nodes",
+				Computed:    true,
+			},
+			"pool_member_capacity": {
+				Type:        schema.TypeInt,
+				Description: "Maximum number of pool members supported on all edge nodes",
+				Computed:    true,
+			},
+			"edge_node_usage": {
+				Type:        schema.TypeList,
+				Description: "Load balancer usage per edge node",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"node_path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of the edge node",
+							Computed:    true,
+						},
+						"edge_cluster_path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of the edge cluster which contains the edge node",
+							Computed:    true,
+						},
+						"form_factor": {
+							Type:        schema.TypeString,
+							Description: "Form factor of the edge node",
+							Computed:    true,
+						},
+						"severity": {
+							Type:        schema.TypeString,
+							Description: "Severity calculated from usage percentage",
+							Computed:    true,
+						},
+						"usage_percentage": {
+							Type:        schema.TypeFloat,
+							Description: "The highest usage percentage among load balancer credits and pool members",
+							Computed:    true,
+						},
+						"current_load_balancer_credits": {
+							Type:        schema.TypeInt,
+							Description: "Number of load balancer credits consumed on the edge node",
+							Computed:    true,
+						},
+						"load_balancer_credit_capacity": {
+							Type:        schema.TypeInt,
+							Description: "Number of load balancer credits available on the edge node",
+							Computed:    true,
+						},
+						"current_virtual_server_count": {
+							Type:        schema.TypeInt,
+							Description: "Number of virtual servers configured on the edge node",
+							Computed:    true,
+						},
+						"current_pool_count": {
+							Type:        schema.TypeInt,
+							Description: "Number of pools configured on the edge node",
+							Computed:    true,
+						},
+						"current_pool_member_count": {
+							Type:        schema.TypeInt,
+							Description: "Number of pool members configured on the edge node",
+							Computed:    true,
+						},
+						"pool_member_capacity": {
+							Type:        schema.TypeInt,
+							Description: "Maximum number of pool members supported on the edge node",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicyLBNodeUsageRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewDefaultLbNodeUsageSummaryClient(connector)
+
+	enforcementPointPath := d.Get("enforcement_point_path").(string)
+	if enforcementPointPath == "" {
+		enforcementPointPath = getPolicyEnforcementPointPath(m)
+	}
+	includeUsages := true
+
+	aggregateSummary, err := client.Get(&enforcementPointPath, &includeUsages)
+	if err != nil {
+		return handleDataSourceReadError(d, "LBNodeUsageSummary", enforcementPointPath, err)
+	}
+
+	if len(aggregateSummary.Results) == 0 {
+		return fmt.Errorf("No load balancer node usage found for enforcement point %s", enforcementPointPath)
+	}
+	summary := aggregateSummary.Results[0]
+
+	d.Set("enforcement_point_path", enforcementPointPath)
+	d.Set("severity", summary.Severity)
+	d.Set("usage_percentage", summary.UsagePercentage)
+	d.Set("current_load_balancer_credits", summary.CurrentLoadBalancerCredits)
+	d.Set("load_balancer_credit_capacity", summary.LoadBalancerCreditCapacity)
+	d.Set("current_pool_member_count", summary.CurrentPoolMemberCount)
+	d.Set("pool_member_capacity", summary.PoolMemberCapacity)
+
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+	var usageList []map[string]interface{}
+	for _, usageValue := range summary.NodeUsages {
+		baseUsage, errs := converter.ConvertToGolang(usageValue, model.LBNodeUsageBindingType())
+		if errs != nil {
+			return fmt.Errorf("Failed to convert load balancer node usage: %v", errs[0])
+		}
+		if baseUsage.(model.LBNodeUsage).ResourceType != model.LBEdgeNodeUsage__TYPE_IDENTIFIER {
+			log.Printf("[DEBUG] Skipping load balancer node usage of type %s", baseUsage.(model.LBNodeUsage).ResourceType)
+			continue
+		}
+
+		edgeUsageValue, errs := converter.ConvertToGolang(usageValue, model.LBEdgeNodeUsageBindingType())
+		if errs != nil {
+			return fmt.Errorf("Failed to convert load balancer edge node usage: %v", errs[0])
+		}
+		edgeUsage := edgeUsageValue.(model.LBEdgeNodeUsage)
+
+		elem := make(map[string]interface{})
+		elem["node_path"] = edgeUsage.NodePath
+		elem["edge_cluster_path"] = edgeUsage.EdgeClusterPath
+		elem["form_factor"] = edgeUsage.FormFactor
+		elem["severity"] = edgeUsage.Severity
+		elem["usage_percentage"] = edgeUsage.UsagePercentage
+		elem["current_load_balancer_credits"] = edgeUsage.CurrentLoadBalancerCredits
+		elem["load_balancer_credit_capacity"] = edgeUsage.LoadBalancerCreditCapacity
+		elem["current_virtual_server_count"] = edgeUsage.CurrentVirtualServerCount
+		elem["current_pool_count"] = edgeUsage.CurrentPoolCount
+		elem["current_pool_member_count"] = edgeUsage.CurrentPoolMemberCount
+		elem["pool_member_capacity"] = edgeUsage.PoolMemberCapacity
+		usageList = append(usageList, elem)
+	}
+	d.Set("edge_node_usage", usageList)
+
+	d.SetId(newUUID())
+	return nil
+}
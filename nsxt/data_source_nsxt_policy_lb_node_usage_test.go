@@ -0,0 +1,39 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyLBNodeUsage_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_lb_node_usage.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBNodeUsageTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "enforcement_point_path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "load_balancer_credit_capacity"),
+					resource.TestCheckResourceAttrSet(testResourceName, "pool_member_capacity"),
+					resource.TestCheckResourceAttrSet(testResourceName, "edge_node_usage.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyLBNodeUsageTemplate() string {
+	return testAccNsxtPolicyLBRuntimeTemplate() + `
+
+data "nsxt_policy_lb_node_usage" "test" {
+  depends_on = [nsxt_policy_lb_virtual_server.test]
+}`
+}
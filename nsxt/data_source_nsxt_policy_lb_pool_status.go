@@ -0,0 +1,159 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/lb_services/lb_pools"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyLBPoolStatus() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyLBPoolStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"id":                     getDataSourceIDSchema(),
+			"service_path":           getPolicyPathSchema(true, false, "Policy path of the load balancer service"),
+			"pool_path":              getPolicyPathSchema(true, false, "Policy path of the load balancer pool"),
+			"enforcement_point_path": getPolicySegmentRuntimeEnforcementPointSchema(),
+			"source":                 getPolicySegmentRuntimeSourceSchema(),
+			"status": {
+				Type:        schema.TypeString,
+				Description: "Pool status",
+				Computed:    true,
+			},
+			"last_update_timestamp": {
+				Type:        schema.TypeInt,
+				Description: "Timestamp when the status was last updated",
+				Computed:    true,
+			},
+			"member": {
+				Type:        schema.TypeList,
+				Description: "Status of pool members",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip_address": {
+							Type:        schema.TypeString,
+							Description: "Pool member IP address",
+							Computed:    true,
+						},
+						"port": {
+							Type:        schema.TypeString,
+							Description: "Pool member port",
+							Computed:    true,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Description: "Pool member status",
+							Computed:    true,
+						},
+						"failure_cause": {
+							Type:        schema.TypeString,
+							Description: "The healthcheck failure cause when status is DOWN",
+							Computed:    true,
+						},
+						"last_check_time": {
+							Type:        schema.TypeInt,
+							Description: "Timestamp when the monitor check was last performed",
+							Computed:    true,
+						},
+						"last_state_change_time": {
+							Type:        schema.TypeInt,
+							Description: "Timestamp when the member status last changed",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicyLBPoolStatusRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	client := lb_pools.NewDefaultDetailedStatusClient(connector)
+
+	serviceID := getPolicyIDFromPath(d.Get("service_path").(string))
+	poolPath := d.Get("pool_path").(string)
+	poolID := getPolicyIDFromPath(poolPath)
+	enforcementPointPath := d.Get("enforcement_point_path").(string)
+	if enforcementPointPath == "" {
+		enforcementPointPath = getPolicyEnforcementPointPath(m)
+	}
+	var source *string
+	if value := d.Get("source").(string); value != "" {
+		source = &value
+	}
+
+	aggregateStatus, err := client.Get(serviceID, poolID, &enforcementPointPath, source)
+	if err != nil {
+		return handleDataSourceReadError(d, "LBPoolStatus", poolID, err)
+	}
+
+	if len(aggregateStatus.Results) == 0 {
+		return fmt.Errorf("No status found for LB Pool %s", poolPath)
+	}
+
+	status, err := getPolicyLBPoolStatusForEnforcementPoint(aggregateStatus.Results, enforcementPointPath)
+	if err != nil {
+		return fmt.Errorf("Failed to get status for LB Pool %s: %v", poolPath, err)
+	}
+
+	d.Set("enforcement_point_path", enforcementPointPath)
+	d.Set("status", status.Status)
+	d.Set("last_update_timestamp", status.LastUpdateTimestamp)
+
+	var memberList []map[string]interface{}
+	for _, member := range status.Members {
+		elem := make(map[string]interface{})
+		elem["ip_address"] = member.IpAddress
+		elem["port"] = member.Port
+		elem["status"] = member.Status
+		elem["failure_cause"] = member.FailureCause
+		elem["last_check_time"] = member.LastCheckTime
+		elem["last_state_change_time"] = member.LastStateChangeTime
+		memberList = append(memberList, elem)
+	}
+	d.Set("member", memberList)
+
+	d.SetId(newUUID())
+	return nil
+}
+
+// Aggregate status holds a result per enforcement point, pick the one that
+// was requested rather than relying on the order of results
+func getPolicyLBPoolStatusForEnforcementPoint(results []*data.StructValue, enforcementPointPath string) (*model.LBPoolStatus, error) {
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+
+	var statuses []model.LBPoolStatus
+	for _, result := range results {
+		statusValue, errs := converter.ConvertToGolang(result, model.LBPoolStatusBindingType())
+		if errs != nil {
+			return nil, errs[0]
+		}
+		status := statusValue.(model.LBPoolStatus)
+		if status.EnforcementPointPath != nil && *status.EnforcementPointPath == enforcementPointPath {
+			return &status, nil
+		}
+		statuses = append(statuses, status)
+	}
+
+	// Older NSX versions do not report enforcement point in the result
+	if len(statuses) == 1 && statuses[0].EnforcementPointPath == nil {
+		return &statuses[0], nil
+	}
+
+	return nil, fmt.Errorf("no status found for enforcement point %s", enforcementPointPath)
+}
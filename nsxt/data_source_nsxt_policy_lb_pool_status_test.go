@@ -0,0 +1,92 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func TestAccDataSourceNsxtPolicyLBPoolStatus_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_lb_pool_status.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBPoolStatusTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "enforcement_point_path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyLBPoolStatusTemplate() string {
+	return testAccNsxtPolicyLBRuntimeTemplate() + `
+
+data "nsxt_policy_lb_pool_status" "test" {
+  service_path = nsxt_policy_lb_service.test.path
+  pool_path    = nsxt_policy_lb_pool.test.path
+  depends_on   = [nsxt_policy_lb_virtual_server.test]
+}`
+}
+
+func testLBPoolStatusResult(t *testing.T, enforcementPointPath string, status string) *data.StructValue {
+	obj := model.LBPoolStatus{
+		Status:       &status,
+		ResourceType: "LBPoolStatus",
+	}
+	if enforcementPointPath != "" {
+		obj.EnforcementPointPath = &enforcementPointPath
+	}
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+	dataValue, errs := converter.ConvertToVapi(obj, model.LBPoolStatusBindingType())
+	if errs != nil {
+		t.Fatalf("Failed to convert status: %v", errs[0])
+	}
+	return dataValue.(*data.StructValue)
+}
+
+func TestGetPolicyLBPoolStatusForEnforcementPoint(t *testing.T) {
+	defaultPath := "/infra/sites/default/enforcement-points/default"
+	otherPath := "/infra/sites/default/enforcement-points/other"
+	cases := []struct {
+		name     string
+		results  []*data.StructValue
+		expected string
+	}{
+		{"matching first", []*data.StructValue{testLBPoolStatusResult(t, defaultPath, "UP"), testLBPoolStatusResult(t, otherPath, "DOWN")}, "UP"},
+		{"matching last", []*data.StructValue{testLBPoolStatusResult(t, otherPath, "DOWN"), testLBPoolStatusResult(t, defaultPath, "UP")}, "UP"},
+		{"no enforcement point", []*data.StructValue{testLBPoolStatusResult(t, "", "UP")}, "UP"},
+		{"not matching", []*data.StructValue{testLBPoolStatusResult(t, otherPath, "DOWN")}, ""},
+		{"several without enforcement point", []*data.StructValue{testLBPoolStatusResult(t, "", "DOWN"), testLBPoolStatusResult(t, "", "UP")}, ""},
+	}
+
+	for _, tc := range cases {
+		status, err := getPolicyLBPoolStatusForEnforcementPoint(tc.results, defaultPath)
+		if tc.expected == "" {
+			if err == nil {
+				t.Errorf("%s: expected error, got status %s", tc.name, *status.Status)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.name, err)
+			continue
+		}
+		if *status.Status != tc.expected {
+			t.Errorf("%s: expected status %s, got %s", tc.name, tc.expected, *status.Status)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/lb_services"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyLBServiceUsage() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyLBServiceUsageRead,
+
+		Schema: map[string]*schema.Schema{
+			"id":                     getDataSourceIDSchema(),
+			"service_path":           getPolicyPathSchema(true, false, "Policy path of the load balancer service"),
+			"enforcement_point_path": getPolicySegmentRuntimeEnforcementPointSchema(),
+			"source":                 getPolicySegmentRuntimeSourceSchema(),
+			"service_size": {
+				Type:        schema.TypeString,
+				Description: "Size of the load balancer service",
+				Computed:    true,
+			},
+			"severity": {
+				Type:        schema.TypeString,
+				Description: "Severity calculated from usage percentage",
+				Computed:    true,
+			},
+			"usage_percentage": {
+				Type:        schema.TypeFloat,
+				Description: "The highest usage percentage among virtual servers, pools and pool members",
+				Computed:    true,
+			},
+			"current_virtual_server_count": {
+				Type:        schema.TypeInt,
+				Description: "Number of virtual servers configured on the service",
+				Computed:    true,
+			},
+			"virtual_server_capacity": {
+				Type:        schema.TypeInt,
+				Description: "Maximum number of virtual servers supported by the service",
+				Computed:    true,
+			},
+			"current_pool_count": {
+				Type:        schema.TypeInt,
+				Description: "Number of pools configured on the service",
+				Computed:    true,
+			},
+			"pool_capacity": {
+				Type:        schema.TypeInt,
+				Description: "Maximum number of pools supported by the service",
+				Computed:    true,
+			},
+			"current_pool_member_count": {
+				Type:        schema.TypeInt,
+				Description: "Number of pool members configured on the service",
+				Computed:    true,
+			},
+			"pool_member_capacity": {
+				Type:        schema.TypeInt,
+				Description: "Maximum number of pool members supported by the service",
+				Computed:    true,
+			},
+			"last_update_timestamp": {
+				Type:        schema.TypeInt,
+				Description: "Timestamp when the usage was last updated",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicyLBServiceUsageRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	client := lb_services.NewDefaultServiceUsageClient(connector)
+
+	servicePath := d.Get("service_path").(string)
+	serviceID := getPolicyIDFromPath(servicePath)
+	enforcementPointPath := d.Get("enforcement_point_path").(string)
+	if enforcementPointPath == "" {
+		enforcementPointPath = getPolicyEnforcementPointPath(m)
+	}
+	var source *string
+	if value := d.Get("source").(string); value != "" {
+		source = &value
+	}
+
+	aggregateUsage, err := client.Get(serviceID, &enforcementPointPath, source)
+	if err != nil {
+		return handleDataSourceReadError(d, "LBServiceUsage", serviceID, err)
+	}
+
+	if len(aggregateUsage.Results) == 0 {
+		return fmt.Errorf("No usage information found for LB Service %s", servicePath)
+	}
+
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+	usageValue, errs := converter.ConvertToGolang(aggregateUsage.Results[0], model.LBServiceUsageBindingType())
+	if errs != nil {
+		return fmt.Errorf("Failed to convert usage information for LB Service %s: %v", servicePath, errs[0])
+	}
+	usage := usageValue.(model.LBServiceUsage)
+
+	d.Set("enforcement_point_path", enforcementPointPath)
+	d.Set("service_size", usage.ServiceSize)
+	d.Set("severity", usage.Severity)
+	d.Set("usage_percentage", usage.UsagePercentage)
+	d.Set("current_virtual_server_count", usage.CurrentVirtualServerCount)
+	d.Set("virtual_server_capacity", usage.VirtualServerCapacity)
+	d.Set("current_pool_count", usage.CurrentPoolCount)
+	d.Set("pool_capacity", usage.PoolCapacity)
+	d.Set("current_pool_member_count", usage.CurrentPoolMemberCount)
+	d.Set("pool_member_capacity", usage.PoolMemberCapacity)
+	d.Set("last_update_timestamp", usage.LastUpdateTimestamp)
+
+	d.SetId(newUUID())
+	return nil
+}
@@ -0,0 +1,88 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyLBServiceUsage_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_lb_service_usage.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBServiceUsageTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "enforcement_point_path"),
+					resource.TestCheckResourceAttr(testResourceName, "service_size", "SMALL"),
+					resource.TestCheckResourceAttr(testResourceName, "current_virtual_server_count", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "current_pool_count", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "virtual_server_capacity"),
+					resource.TestCheckResourceAttrSet(testResourceName, "pool_capacity"),
+					resource.TestCheckResourceAttrSet(testResourceName, "pool_member_capacity"),
+					resource.TestCheckResourceAttrSet(testResourceName, "severity"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyLBServiceUsageTemplate() string {
+	return testAccNsxtPolicyLBRuntimeTemplate() + `
+
+data "nsxt_policy_lb_service_usage" "test" {
+  service_path = nsxt_policy_lb_service.test.path
+  depends_on   = [nsxt_policy_lb_virtual_server.test]
+}`
+}
+
+// Load balancer with one virtual server and pool, used by runtime data source tests
+func testAccNsxtPolicyLBRuntimeTemplate() string {
+	name := getAccTestResourceName()
+	return fmt.Sprintf(`
+data "nsxt_policy_edge_cluster" "test" {
+  display_name = "%s"
+}
+
+data "nsxt_policy_lb_app_profile" "default_tcp" {
+  type = "TCP"
+}
+
+resource "nsxt_policy_tier1_gateway" "test" {
+  display_name      = "%s"
+  edge_cluster_path = data.nsxt_policy_edge_cluster.test.path
+}
+
+resource "nsxt_policy_lb_service" "test" {
+  display_name      = "%s"
+  connectivity_path = nsxt_policy_tier1_gateway.test.path
+  size              = "SMALL"
+}
+
+resource "nsxt_policy_lb_pool" "test" {
+  display_name = "%s"
+
+  member {
+    display_name = "member1"
+    ip_address   = "5.5.5.5"
+    port         = "80"
+  }
+}
+
+resource "nsxt_policy_lb_virtual_server" "test" {
+  display_name             = "%s"
+  application_profile_path = data.nsxt_policy_lb_app_profile.default_tcp.path
+  ip_address               = "1.1.1.1"
+  ports                    = ["80"]
+  service_path             = nsxt_policy_lb_service.test.path
+  pool_path                = nsxt_policy_lb_pool.test.path
+}`, getEdgeClusterName(), name, name, name, name)
+}
@@ -0,0 +1,186 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data/serializers/cleanjson"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+)
+
+func dataSourceNsxtPolicyObject() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyObjectRead,
+
+		Schema: map[string]*schema.Schema{
+			"id":            getDataSourceIDSchema(),
+			"display_name":  getDataSourceDisplayNameSchema(),
+			"description":   getDataSourceDescriptionSchema(),
+			"path":          getPathSchema(),
+			"resource_type": getPolicyObjectResourceTypeSchema(),
+			"path_prefix":   getPolicyObjectPathPrefixSchema(),
+			"tag_scope":     getPolicyObjectTagScopeSchema(),
+			"tag_value":     getPolicyObjectTagValueSchema(),
+			"query":         getPolicyObjectQuerySchema(),
+			"tag":           getPolicyObjectTagsSchema(),
+			"json":          getPolicyObjectJSONSchema(),
+		},
+	}
+}
+
+func getPolicyObjectResourceTypeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Resource type of the object, for example Group or Tier1",
+		Required:    true,
+	}
+}
+
+func getPolicyObjectPathPrefixSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Policy path prefix of the object",
+		Optional:    true,
+	}
+}
+
+func getPolicyObjectTagScopeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Scope of a tag assigned to the object",
+		Optional:    true,
+	}
+}
+
+func getPolicyObjectTagValueSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Value of a tag assigned to the object",
+		Optional:    true,
+	}
+}
+
+func getPolicyObjectQuerySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Additional search query in NSX search syntax",
+		Optional:    true,
+	}
+}
+
+func getPolicyObjectTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Tags assigned to the object",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"scope": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"tag": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func getPolicyObjectJSONSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Full object as returned by NSX in JSON format",
+		Computed:    true,
+	}
+}
+
+func getPolicyObjectSearchQuery(d *schema.ResourceData) string {
+	filters := make(map[string]string)
+	if pathPrefix := d.Get("path_prefix").(string); pathPrefix != "" {
+		filters["path"] = pathPrefix + "*"
+	}
+	if tagScope := d.Get("tag_scope").(string); tagScope != "" {
+		filters["tags.scope"] = tagScope
+	}
+	if tagValue := d.Get("tag_value").(string); tagValue != "" {
+		filters["tags.tag"] = tagValue
+	}
+
+	query := buildQueryStringFromMap(filters)
+	if rawQuery := d.Get("query").(string); rawQuery != "" {
+		if query != "" {
+			query += " AND "
+		}
+		query += fmt.Sprintf("(%s)", rawQuery)
+	}
+
+	return query
+}
+
+func getPolicyObjectTagsFromStruct(policyResource model.PolicyResource) []map[string]interface{} {
+	var tags []map[string]interface{}
+	for _, tag := range policyResource.Tags {
+		elem := make(map[string]interface{})
+		elem["scope"] = tag.Scope
+		elem["tag"] = tag.Tag
+		tags = append(tags, elem)
+	}
+	return tags
+}
+
+func convertPolicyObjectStruct(structValue *data.StructValue) (model.PolicyResource, string, error) {
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+	dataValue, errors := converter.ConvertToGolang(structValue, model.PolicyResourceBindingType())
+	if len(errors) > 0 {
+		return model.PolicyResource{}, "", errors[0]
+	}
+
+	objJSON, err := cleanjson.NewDataValueToJsonEncoder().Encode(structValue)
+	if err != nil {
+		return model.PolicyResource{}, "", fmt.Errorf("Failed to encode object to JSON: %w", err)
+	}
+
+	return dataValue.(model.PolicyResource), objJSON, nil
+}
+
+func dataSourceNsxtPolicyObjectRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	isGlobalManager := isPolicyGlobalManager(m)
+	resourceType := d.Get("resource_type").(string)
+	objID := d.Get("id").(string)
+	query := getPolicyObjectSearchQuery(d)
+
+	var resultValues []*data.StructValue
+	var err error
+	if objID != "" {
+		resultValues, err = listPolicyResourcesByID(connector, isGlobalManager, &objID, &query)
+	} else {
+		resultValues, err = listPolicyResourcesByType(connector, isGlobalManager, &resourceType, &query)
+	}
+	if err != nil {
+		return handleListError(resourceType, err)
+	}
+
+	structValue, err := policyDataSourceResourceFilterAndSet(d, resultValues, resourceType)
+	if err != nil {
+		return err
+	}
+
+	policyResource, objJSON, err := convertPolicyObjectStruct(structValue)
+	if err != nil {
+		return err
+	}
+
+	d.Set("tag", getPolicyObjectTagsFromStruct(policyResource))
+	d.Set("json", objJSON)
+
+	return nil
+}
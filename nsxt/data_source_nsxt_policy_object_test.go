@@ -0,0 +1,56 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceNsxtPolicyObject_basic(t *testing.T) {
+	name := getAccTestDataSourceName()
+	domain := "default"
+	testResourceName := "data.nsxt_policy_object.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccDataSourceNsxtPolicyGroupDeleteByName(domain, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if err := testAccDataSourceNsxtPolicyGroupCreate(domain, name); err != nil {
+						panic(err)
+					}
+				},
+				Config: testAccNsxtPolicyObjectReadTemplate(domain, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", name),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "json"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyObjectReadTemplate(domain string, name string) string {
+	infra := "infra"
+	if testAccIsGlobalManager() {
+		infra = "global-infra"
+	}
+	return fmt.Sprintf(`
+data "nsxt_policy_object" "test" {
+  resource_type = "Group"
+  display_name  = "%s"
+  path_prefix   = "/%s/domains/%s/groups/"
+}`, name, infra, domain)
+}
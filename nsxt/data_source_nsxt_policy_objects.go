@@ -0,0 +1,90 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNsxtPolicyObjects() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyObjectsRead,
+
+		Schema: map[string]*schema.Schema{
+			"id":            getDataSourceIDSchema(),
+			"resource_type": getPolicyObjectResourceTypeSchema(),
+			"path_prefix":   getPolicyObjectPathPrefixSchema(),
+			"tag_scope":     getPolicyObjectTagScopeSchema(),
+			"tag_value":     getPolicyObjectTagValueSchema(),
+			"query":         getPolicyObjectQuerySchema(),
+			"items": {
+				Type:        schema.TypeList,
+				Description: "Objects matching the criteria",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Description: "ID of the object",
+							Computed:    true,
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Description: "Display name of the object",
+							Computed:    true,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Description: "Description of the object",
+							Computed:    true,
+						},
+						"path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of the object",
+							Computed:    true,
+						},
+						"tag":  getPolicyObjectTagsSchema(),
+						"json": getPolicyObjectJSONSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicyObjectsRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	resourceType := d.Get("resource_type").(string)
+	query := getPolicyObjectSearchQuery(d)
+
+	resultValues, err := listPolicyResourcesByType(connector, isPolicyGlobalManager(m), &resourceType, &query)
+	if err != nil {
+		return handleListError(resourceType, err)
+	}
+
+	var items []map[string]interface{}
+	for _, result := range resultValues {
+		policyResource, objJSON, err := convertPolicyObjectStruct(result)
+		if err != nil {
+			return err
+		}
+		if policyResource.ResourceType == nil || *policyResource.ResourceType != resourceType {
+			continue
+		}
+
+		elem := make(map[string]interface{})
+		elem["id"] = policyResource.Id
+		elem["display_name"] = policyResource.DisplayName
+		elem["description"] = policyResource.Description
+		elem["path"] = policyResource.Path
+		elem["tag"] = getPolicyObjectTagsFromStruct(policyResource)
+		elem["json"] = objJSON
+		items = append(items, elem)
+	}
+
+	d.Set("items", items)
+	d.SetId(newUUID())
+
+	return nil
+}
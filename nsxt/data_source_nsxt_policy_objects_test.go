@@ -0,0 +1,52 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceNsxtPolicyObjects_basic(t *testing.T) {
+	name := getAccTestDataSourceName()
+	domain := "default"
+	testResourceName := "data.nsxt_policy_objects.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccDataSourceNsxtPolicyGroupDeleteByName(domain, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if err := testAccDataSourceNsxtPolicyGroupCreate(domain, name); err != nil {
+						panic(err)
+					}
+				},
+				Config: testAccNsxtPolicyObjectsReadTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "items.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "items.0.display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "items.0.description", name),
+					resource.TestCheckResourceAttrSet(testResourceName, "items.0.id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "items.0.path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "items.0.json"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyObjectsReadTemplate(name string) string {
+	return fmt.Sprintf(`
+data "nsxt_policy_objects" "test" {
+  resource_type = "Group"
+  query         = "display_name:%s"
+}`, name)
+}
@@ -157,9 +157,9 @@ func dataSourceNsxtPolicyRealizationInfoRead(d *schema.ResourceData, m interface
 		MinTimeout: 1 * time.Second,
 		Delay:      time.Duration(delay) * time.Second,
 	}
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(getProviderContext(m))
 	if err != nil {
-		return fmt.Errorf("Failed to get realization information for %s: %v", path, err)
+		return fmt.Errorf("Failed to get realization information for %s: %w", path, err)
 	}
 	return nil
 }
@@ -114,7 +114,7 @@ func dataSourceNsxtPolicySecurityPolicyRead(d *schema.ResourceData, m interface{
 		}
 
 		if err != nil {
-			return fmt.Errorf("Error while reading Security Policy %s: %v", objID, err)
+			return fmt.Errorf("Error while reading Security Policy %s: %w", objID, err)
 		}
 		obj = objGet
 	} else if objName == "" && category == "" {
@@ -122,7 +122,7 @@ func dataSourceNsxtPolicySecurityPolicyRead(d *schema.ResourceData, m interface{
 	} else {
 		objList, err := listSecurityPolicies(domain, connector)
 		if err != nil {
-			return fmt.Errorf("Error while reading Security Policies: %v", err)
+			return fmt.Errorf("Error while reading Security Policies: %w", err)
 		}
 		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
 		var perfectMatch []model.SecurityPolicy
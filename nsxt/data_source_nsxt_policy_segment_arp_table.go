@@ -0,0 +1,97 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/segments"
+	t1_segments "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s/segments"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicySegmentArpTable() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicySegmentArpTableRead,
+
+		Schema: map[string]*schema.Schema{
+			"id":                     getDataSourceIDSchema(),
+			"segment_path":           getPolicySegmentRuntimeSegmentPathSchema(),
+			"enforcement_point_path": getPolicySegmentRuntimeEnforcementPointSchema(),
+			"edge_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of edge node",
+				Required:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"entry": {
+				Type:        schema.TypeList,
+				Description: "ARP table entries",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip": {
+							Type:        schema.TypeString,
+							Description: "IP address",
+							Computed:    true,
+						},
+						"mac_address": {
+							Type:        schema.TypeString,
+							Description: "MAC address",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicySegmentArpTableRead(d *schema.ResourceData, m interface{}) error {
+	gwID, segmentID, enforcementPointPath, err := getPolicySegmentRuntimeParams(d, m)
+	if err != nil {
+		return err
+	}
+
+	connector := getPolicyConnector(m)
+	edgePath := d.Get("edge_path").(string)
+	// Tables of large segments are returned in several pages
+	var results []model.InterfaceArpEntry
+	var cursor *string
+	for {
+		var table model.InterfaceArpTable
+		if gwID == "" {
+			client := segments.NewDefaultArpTableClient(connector)
+			table, err = client.List(segmentID, cursor, &edgePath, &enforcementPointPath, nil, nil, nil, nil)
+		} else {
+			client := t1_segments.NewDefaultArpTableClient(connector)
+			table, err = client.List(gwID, segmentID, cursor, &edgePath, &enforcementPointPath, nil, nil, nil, nil)
+		}
+		if err != nil {
+			return handleDataSourceReadError(d, "Segment ARP Table", segmentID, err)
+		}
+		results = append(results, table.Results...)
+		cursor = table.Cursor
+		if cursor == nil || *cursor == "" || len(table.Results) == 0 {
+			break
+		}
+	}
+
+	var entries []map[string]interface{}
+	for _, entry := range results {
+		elem := make(map[string]interface{})
+		elem["ip"] = entry.Ip
+		elem["mac_address"] = entry.MacAddress
+		entries = append(entries, elem)
+	}
+
+	err = d.Set("entry", entries)
+	if err != nil {
+		return fmt.Errorf("Error setting ARP table entries for segment %s: %w", segmentID, err)
+	}
+
+	d.SetId(newUUID())
+	return nil
+}
@@ -0,0 +1,59 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicySegmentArpTable_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_segment_arp_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t); testAccNSXVersion(t, "3.0.0") },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySegmentArpTableTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "enforcement_point_path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "entry.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySegmentRuntimeGatewayTemplate() string {
+	return testAccNsxtPolicyEdgeNodeReadTemplate(getEdgeClusterName()) +
+		testAccNSXPolicyTransportZoneReadTemplate(getOverlayTransportZoneName(), false, true) + fmt.Sprintf(`
+
+resource "nsxt_policy_tier1_gateway" "test" {
+  display_name      = "%s"
+  edge_cluster_path = data.nsxt_policy_edge_cluster.test.path
+}
+
+resource "nsxt_policy_segment" "test" {
+  display_name        = "%s"
+  transport_zone_path = data.nsxt_policy_transport_zone.test.path
+  connectivity_path   = nsxt_policy_tier1_gateway.test.path
+
+  subnet {
+    cidr = "12.12.2.1/24"
+  }
+}`, getAccTestResourceName(), getAccTestResourceName())
+}
+
+func testAccNsxtPolicySegmentArpTableTemplate() string {
+	return testAccNsxtPolicySegmentRuntimeGatewayTemplate() + `
+
+data "nsxt_policy_segment_arp_table" "test" {
+  segment_path = nsxt_policy_segment.test.path
+  edge_path    = data.nsxt_policy_edge_node.test.path
+}`
+}
@@ -0,0 +1,133 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/segments"
+	t1_segments "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s/segments"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var policySegmentRuntimeSourceValues = []string{"realtime", "cached"}
+
+func getPolicySegmentRuntimeSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "Data source type",
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice(policySegmentRuntimeSourceValues, false),
+	}
+}
+
+func getPolicySegmentRuntimeTransportNodeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "ID of transport node to retrieve the table from",
+		Optional:    true,
+	}
+}
+
+func dataSourceNsxtPolicySegmentMacTable() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicySegmentMacTableRead,
+
+		Schema: map[string]*schema.Schema{
+			"id":                     getDataSourceIDSchema(),
+			"segment_path":           getPolicySegmentRuntimeSegmentPathSchema(),
+			"enforcement_point_path": getPolicySegmentRuntimeEnforcementPointSchema(),
+			"transport_node_id":      getPolicySegmentRuntimeTransportNodeSchema(),
+			"source":                 getPolicySegmentRuntimeSourceSchema(),
+			"entry": {
+				Type:        schema.TypeList,
+				Description: "MAC table entries",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mac_address": {
+							Type:        schema.TypeString,
+							Description: "MAC address",
+							Computed:    true,
+						},
+						"vtep_ip": {
+							Type:        schema.TypeString,
+							Description: "IP address of the tunnel endpoint",
+							Computed:    true,
+						},
+						"vtep_mac_address": {
+							Type:        schema.TypeString,
+							Description: "MAC address of the tunnel endpoint",
+							Computed:    true,
+						},
+						"vtep_group_id": {
+							Type:        schema.TypeInt,
+							Description: "ID of the tunnel endpoint group",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicySegmentMacTableRead(d *schema.ResourceData, m interface{}) error {
+	gwID, segmentID, enforcementPointPath, err := getPolicySegmentRuntimeParams(d, m)
+	if err != nil {
+		return err
+	}
+
+	connector := getPolicyConnector(m)
+	var transportNodeID *string
+	var source *string
+	if value := d.Get("transport_node_id").(string); value != "" {
+		transportNodeID = &value
+	}
+	if value := d.Get("source").(string); value != "" {
+		source = &value
+	}
+
+	// Tables of large segments are returned in several pages
+	var results []model.MacTableEntry
+	var cursor *string
+	for {
+		var table model.SegmentMacAddressListResult
+		if gwID == "" {
+			client := segments.NewDefaultMacTableClient(connector)
+			table, err = client.List(segmentID, cursor, &enforcementPointPath, nil, nil, nil, nil, source, transportNodeID)
+		} else {
+			client := t1_segments.NewDefaultMacTableClient(connector)
+			table, err = client.List(gwID, segmentID, cursor, &enforcementPointPath, nil, nil, nil, nil, source, transportNodeID)
+		}
+		if err != nil {
+			return handleDataSourceReadError(d, "Segment MAC Table", segmentID, err)
+		}
+		results = append(results, table.Results...)
+		cursor = table.Cursor
+		if cursor == nil || *cursor == "" || len(table.Results) == 0 {
+			break
+		}
+	}
+
+	var entries []map[string]interface{}
+	for _, entry := range results {
+		elem := make(map[string]interface{})
+		elem["mac_address"] = entry.MacAddress
+		elem["vtep_ip"] = entry.VtepIp
+		elem["vtep_mac_address"] = entry.VtepMacAddress
+		elem["vtep_group_id"] = entry.VtepGroupId
+		entries = append(entries, elem)
+	}
+
+	err = d.Set("entry", entries)
+	if err != nil {
+		return fmt.Errorf("Error setting MAC table entries for segment %s: %w", segmentID, err)
+	}
+
+	d.SetId(newUUID())
+	return nil
+}
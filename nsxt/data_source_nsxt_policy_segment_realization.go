@@ -88,9 +88,9 @@ func dataSourceNsxtPolicySegmentRealizationRead(d *schema.ResourceData, m interf
 		MinTimeout: 1 * time.Second,
 		Delay:      1 * time.Second,
 	}
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(getProviderContext(m))
 	if err != nil {
-		return fmt.Errorf("Failed to get realization information for %s: %v", path, err)
+		return fmt.Errorf("Failed to get realization information for %s: %w", path, err)
 	}
 
 	// In some cases success state is returned a moment before VC actually sees the network
@@ -0,0 +1,132 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/segments"
+	t1_segments "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s/segments"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func getPolicySegmentDataCounterSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"total": {
+					Type:        schema.TypeInt,
+					Description: "Total count",
+					Computed:    true,
+				},
+				"dropped": {
+					Type:        schema.TypeInt,
+					Description: "Dropped count",
+					Computed:    true,
+				},
+				"multicast_broadcast": {
+					Type:        schema.TypeInt,
+					Description: "Multicast and broadcast count",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicySegmentStatistics() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicySegmentStatisticsRead,
+
+		Schema: map[string]*schema.Schema{
+			"id":                     getDataSourceIDSchema(),
+			"segment_path":           getPolicySegmentRuntimeSegmentPathSchema(),
+			"enforcement_point_path": getPolicySegmentRuntimeEnforcementPointSchema(),
+			"edge_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of edge node",
+				Optional:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"rx_bytes":   getPolicySegmentDataCounterSchema("Received bytes counters"),
+			"rx_packets": getPolicySegmentDataCounterSchema("Received packets counters"),
+			"tx_bytes":   getPolicySegmentDataCounterSchema("Transmitted bytes counters"),
+			"tx_packets": getPolicySegmentDataCounterSchema("Transmitted packets counters"),
+			"macs_learned": {
+				Type:        schema.TypeInt,
+				Description: "Number of MACs learned",
+				Computed:    true,
+			},
+			"mac_not_learned_packets_allowed": {
+				Type:        schema.TypeInt,
+				Description: "Number of packets with unknown source MAC address that were allowed",
+				Computed:    true,
+			},
+			"mac_not_learned_packets_dropped": {
+				Type:        schema.TypeInt,
+				Description: "Number of packets with unknown source MAC address that were dropped",
+				Computed:    true,
+			},
+			"last_update_timestamp": {
+				Type:        schema.TypeInt,
+				Description: "Timestamp when the data was last updated",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func setPolicySegmentDataCounterInSchema(d *schema.ResourceData, attrName string, counter *model.DataCounter) {
+	var counterList []map[string]interface{}
+	if counter != nil {
+		elem := make(map[string]interface{})
+		elem["total"] = counter.Total
+		elem["dropped"] = counter.Dropped
+		elem["multicast_broadcast"] = counter.MulticastBroadcast
+		counterList = append(counterList, elem)
+	}
+
+	d.Set(attrName, counterList)
+}
+
+func dataSourceNsxtPolicySegmentStatisticsRead(d *schema.ResourceData, m interface{}) error {
+	gwID, segmentID, enforcementPointPath, err := getPolicySegmentRuntimeParams(d, m)
+	if err != nil {
+		return err
+	}
+
+	connector := getPolicyConnector(m)
+	var edgePath *string
+	if value := d.Get("edge_path").(string); value != "" {
+		edgePath = &value
+	}
+
+	var stats model.SegmentStatistics
+	if gwID == "" {
+		client := segments.NewDefaultStatisticsClient(connector)
+		stats, err = client.Get(segmentID, nil, edgePath, &enforcementPointPath, nil, nil, nil, nil, nil)
+	} else {
+		client := t1_segments.NewDefaultStatisticsClient(connector)
+		stats, err = client.Get(gwID, segmentID, nil, edgePath, &enforcementPointPath, nil, nil, nil, nil, nil)
+	}
+	if err != nil {
+		return handleDataSourceReadError(d, "Segment Statistics", segmentID, err)
+	}
+
+	setPolicySegmentDataCounterInSchema(d, "rx_bytes", stats.RxBytes)
+	setPolicySegmentDataCounterInSchema(d, "rx_packets", stats.RxPackets)
+	setPolicySegmentDataCounterInSchema(d, "tx_bytes", stats.TxBytes)
+	setPolicySegmentDataCounterInSchema(d, "tx_packets", stats.TxPackets)
+	if stats.MacLearning != nil {
+		d.Set("macs_learned", stats.MacLearning.MacsLearned)
+		d.Set("mac_not_learned_packets_allowed", stats.MacLearning.MacNotLearnedPacketsAllowed)
+		d.Set("mac_not_learned_packets_dropped", stats.MacLearning.MacNotLearnedPacketsDropped)
+	}
+	d.Set("last_update_timestamp", stats.LastUpdateTimestamp)
+
+	d.SetId(newUUID())
+	return nil
+}
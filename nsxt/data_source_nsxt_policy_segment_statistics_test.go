@@ -0,0 +1,39 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicySegmentStatistics_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_segment_statistics.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t); testAccNSXVersion(t, "3.0.0") },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySegmentStatisticsTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "enforcement_point_path"),
+					resource.TestCheckResourceAttr(testResourceName, "rx_packets.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "tx_packets.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "rx_packets.0.total"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySegmentStatisticsTemplate() string {
+	return testAccNsxtPolicySegmentRuntimeGatewayTemplate() + `
+
+data "nsxt_policy_segment_statistics" "test" {
+  segment_path = nsxt_policy_segment.test.path
+}`
+}
@@ -0,0 +1,107 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/segments"
+	t1_segments "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s/segments"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicySegmentTepTable() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicySegmentTepTableRead,
+
+		Schema: map[string]*schema.Schema{
+			"id":                     getDataSourceIDSchema(),
+			"segment_path":           getPolicySegmentRuntimeSegmentPathSchema(),
+			"enforcement_point_path": getPolicySegmentRuntimeEnforcementPointSchema(),
+			"transport_node_id":      getPolicySegmentRuntimeTransportNodeSchema(),
+			"source":                 getPolicySegmentRuntimeSourceSchema(),
+			"entry": {
+				Type:        schema.TypeList,
+				Description: "TEP table entries",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tep_ip": {
+							Type:        schema.TypeString,
+							Description: "IP address of the tunnel endpoint",
+							Computed:    true,
+						},
+						"tep_mac_address": {
+							Type:        schema.TypeString,
+							Description: "MAC address of the tunnel endpoint",
+							Computed:    true,
+						},
+						"tep_label": {
+							Type:        schema.TypeInt,
+							Description: "Label of the tunnel endpoint",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicySegmentTepTableRead(d *schema.ResourceData, m interface{}) error {
+	gwID, segmentID, enforcementPointPath, err := getPolicySegmentRuntimeParams(d, m)
+	if err != nil {
+		return err
+	}
+
+	connector := getPolicyConnector(m)
+	var transportNodeID *string
+	var source *string
+	if value := d.Get("transport_node_id").(string); value != "" {
+		transportNodeID = &value
+	}
+	if value := d.Get("source").(string); value != "" {
+		source = &value
+	}
+
+	// Tables of large segments are returned in several pages
+	var results []model.PolicyTepTableEntry
+	var cursor *string
+	for {
+		var table model.PolicyTepListResult
+		if gwID == "" {
+			client := segments.NewDefaultTepTableClient(connector)
+			table, err = client.List(segmentID, cursor, &enforcementPointPath, nil, nil, nil, nil, source, transportNodeID)
+		} else {
+			client := t1_segments.NewDefaultTepTableClient(connector)
+			table, err = client.List(gwID, segmentID, cursor, &enforcementPointPath, nil, nil, nil, nil, source, transportNodeID)
+		}
+		if err != nil {
+			return handleDataSourceReadError(d, "Segment TEP Table", segmentID, err)
+		}
+		results = append(results, table.Results...)
+		cursor = table.Cursor
+		if cursor == nil || *cursor == "" || len(table.Results) == 0 {
+			break
+		}
+	}
+
+	var entries []map[string]interface{}
+	for _, entry := range results {
+		elem := make(map[string]interface{})
+		elem["tep_ip"] = entry.TepIp
+		elem["tep_mac_address"] = entry.TepMacAddress
+		elem["tep_label"] = entry.TepLabel
+		entries = append(entries, elem)
+	}
+
+	err = d.Set("entry", entries)
+	if err != nil {
+		return fmt.Errorf("Error setting TEP table entries for segment %s: %w", segmentID, err)
+	}
+
+	d.SetId(newUUID())
+	return nil
+}
@@ -0,0 +1,38 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicySegmentTepTable_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_segment_tep_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t); testAccNSXVersion(t, "3.0.0") },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySegmentTepTableTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "enforcement_point_path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "entry.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySegmentTepTableTemplate() string {
+	return testAccNsxtPolicySegmentRuntimeGatewayTemplate() + `
+
+data "nsxt_policy_segment_tep_table" "test" {
+  segment_path = nsxt_policy_segment.test.path
+  source       = "realtime"
+}`
+}
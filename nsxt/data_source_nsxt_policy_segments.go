@@ -0,0 +1,26 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNsxtPolicySegments() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicySegmentsRead,
+		Schema: getPolicyDataSourceListSchema(map[string]*schema.Schema{
+			"connectivity_path": getPolicyPathSchema(false, false, "Policy path of the gateway the segments are connected to"),
+		}),
+	}
+}
+
+func dataSourceNsxtPolicySegmentsRead(d *schema.ResourceData, m interface{}) error {
+	query := make(map[string]string)
+	if connectivityPath := d.Get("connectivity_path").(string); connectivityPath != "" {
+		query["connectivity_path"] = connectivityPath
+	}
+
+	return policyDataSourceResourceListRead(d, getPolicyConnector(m), isPolicyGlobalManager(m), "Segment", query)
+}
@@ -0,0 +1,56 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicySegments_basic(t *testing.T) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_segments.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySegmentsReadTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "paths.%", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "ids.%", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "items.#", "1"),
+					resource.TestCheckResourceAttrPair(testResourceName, fmt.Sprintf("paths.%s", name), "nsxt_policy_segment.test", "path"),
+					resource.TestCheckResourceAttrPair(testResourceName, fmt.Sprintf("ids.%s", name), "nsxt_policy_segment.test", "nsx_id"),
+					resource.TestCheckResourceAttrPair(testResourceName, "items.0.id", "nsxt_policy_segment.test", "nsx_id"),
+					resource.TestCheckResourceAttrPair(testResourceName, "items.0.path", "nsxt_policy_segment.test", "path"),
+					resource.TestCheckResourceAttr(testResourceName, "items.0.display_name", name),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySegmentsReadTemplate(name string) string {
+	return testAccNSXPolicyTransportZoneReadTemplate(getOverlayTransportZoneName(), false, false) + fmt.Sprintf(`
+resource "nsxt_policy_segment" "test" {
+  display_name        = "%s"
+  transport_zone_path = data.nsxt_policy_transport_zone.test.path
+
+  tag {
+    scope = "env"
+    tag   = "%s"
+  }
+}
+
+data "nsxt_policy_segments" "test" {
+  tag_scope = "env"
+  tag_value = "%s"
+
+  depends_on = [nsxt_policy_segment.test]
+}`, name, name, name)
+}
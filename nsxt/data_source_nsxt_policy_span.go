@@ -0,0 +1,72 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gm_infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
+)
+
+func dataSourceNsxtPolicySpan() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicySpanRead,
+
+		Schema: map[string]*schema.Schema{
+			"id":        getDataSourceIDSchema(),
+			"path":      getPolicyPathSchema(true, false, "Policy path of the global object"),
+			"site_path": getPolicyPathSchema(false, false, "Policy path of the site to check span against"),
+			"site_paths": {
+				Type:        schema.TypeList,
+				Description: "Policy paths of the sites the object spans to",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"span_leader": {
+				Type:        schema.TypeString,
+				Description: "Policy path of the object that determines the span",
+				Computed:    true,
+			},
+			"span_resource_type": {
+				Type:        schema.TypeString,
+				Description: "Resource type of the object that determines the span",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicySpanRead(d *schema.ResourceData, m interface{}) error {
+	if !isPolicyGlobalManager(m) {
+		return globalManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	client := gm_infra.NewDefaultSpanClient(connector)
+
+	path := d.Get("path").(string)
+	var sitePath *string
+	if value := d.Get("site_path").(string); value != "" {
+		sitePath = &value
+	}
+
+	span, err := client.Get(path, sitePath)
+	if err != nil {
+		return handleDataSourceReadError(d, "Span", path, err)
+	}
+
+	var sitePaths []string
+	for _, site := range span.Sites {
+		if site.SitePath != nil {
+			sitePaths = append(sitePaths, *site.SitePath)
+		}
+	}
+	d.Set("site_paths", sitePaths)
+	d.Set("span_leader", span.SpanLeader)
+	d.Set("span_resource_type", span.SpanResourceType)
+
+	d.SetId(newUUID())
+	return nil
+}
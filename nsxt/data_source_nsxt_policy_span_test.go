@@ -0,0 +1,42 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicySpan_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_span.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPolicyDomainDeploymentMapPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySpanTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttr(testResourceName, "site_paths.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySpanTemplate() string {
+	return testAccNsxtPolicyDomainDeploymentMapTemplate(true) + `
+
+resource "nsxt_policy_group" "test" {
+  display_name = "terraform-span-test"
+  domain       = nsxt_policy_domain.test.nsx_id
+}
+
+data "nsxt_policy_span" "test" {
+  path       = nsxt_policy_group.test.path
+  depends_on = [nsxt_policy_domain_deployment_map.test]
+}`
+}
@@ -57,7 +57,7 @@ func dataSourceNsxtPolicyTier0GatewayRead(d *schema.ResourceData, m interface{})
 		}
 
 		if err != nil {
-			return fmt.Errorf("Error while reading Tier0 %s: %v", objID, err)
+			return fmt.Errorf("Error while reading Tier0 %s: %w", objID, err)
 		}
 		obj = objGet
 	} else if objName == "" {
@@ -67,7 +67,7 @@ func dataSourceNsxtPolicyTier0GatewayRead(d *schema.ResourceData, m interface{})
 		includeMarkForDeleteObjectsParam := false
 		objList, err := client.List(nil, &includeMarkForDeleteObjectsParam, nil, nil, nil, nil)
 		if err != nil {
-			return fmt.Errorf("Error while reading Tier0s: %v", err)
+			return fmt.Errorf("Error while reading Tier0s: %w", err)
 		}
 		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
 		var perfectMatch []model.Tier0
@@ -124,7 +124,7 @@ func dataSourceNsxtPolicyTier1GatewayRead(d *schema.ResourceData, m interface{})
 	d.Set("path", obj.Path)
 	err := resourceNsxtPolicyTier1GatewayReadEdgeCluster(d, connector)
 	if err != nil {
-		return fmt.Errorf("Failed to get Tier1 %s locale-services: %v", *obj.Id, err)
+		return fmt.Errorf("Failed to get Tier1 %s locale-services: %w", *obj.Id, err)
 	}
 	return nil
 }
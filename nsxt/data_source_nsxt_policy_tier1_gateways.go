@@ -0,0 +1,26 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNsxtPolicyTier1Gateways() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyTier1GatewaysRead,
+		Schema: getPolicyDataSourceListSchema(map[string]*schema.Schema{
+			"tier0_path": getPolicyPathSchema(false, false, "Policy path of the Tier-0 gateway the Tier-1 gateways are connected to"),
+		}),
+	}
+}
+
+func dataSourceNsxtPolicyTier1GatewaysRead(d *schema.ResourceData, m interface{}) error {
+	query := make(map[string]string)
+	if tier0Path := d.Get("tier0_path").(string); tier0Path != "" {
+		query["tier0_path"] = tier0Path
+	}
+
+	return policyDataSourceResourceListRead(d, getPolicyConnector(m), isPolicyGlobalManager(m), "Tier1", query)
+}
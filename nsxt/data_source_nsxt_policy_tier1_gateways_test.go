@@ -0,0 +1,55 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyTier1Gateways_basic(t *testing.T) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_tier1_gateways.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTier1GatewaysReadTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "paths.%", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "ids.%", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "items.#", "1"),
+					resource.TestCheckResourceAttrPair(testResourceName, fmt.Sprintf("paths.%s", name), "nsxt_policy_tier1_gateway.test", "path"),
+					resource.TestCheckResourceAttrPair(testResourceName, fmt.Sprintf("ids.%s", name), "nsxt_policy_tier1_gateway.test", "nsx_id"),
+					resource.TestCheckResourceAttrPair(testResourceName, "items.0.id", "nsxt_policy_tier1_gateway.test", "nsx_id"),
+					resource.TestCheckResourceAttrPair(testResourceName, "items.0.path", "nsxt_policy_tier1_gateway.test", "path"),
+					resource.TestCheckResourceAttr(testResourceName, "items.0.display_name", name),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyTier1GatewaysReadTemplate(name string) string {
+	return fmt.Sprintf(`
+data "nsxt_policy_tier0_gateway" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_tier1_gateway" "test" {
+  display_name = "%s"
+  tier0_path   = data.nsxt_policy_tier0_gateway.test.path
+}
+
+data "nsxt_policy_tier1_gateways" "test" {
+  tier0_path         = data.nsxt_policy_tier0_gateway.test.path
+  display_name_regex = "^%s$"
+
+  depends_on = [nsxt_policy_tier1_gateway.test]
+}`, getTier0RouterName(), name, name)
+}
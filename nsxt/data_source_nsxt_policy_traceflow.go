@@ -0,0 +1,444 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/traceflows"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var policyTraceflowProtocolValues = []string{"ICMP", "TCP", "UDP"}
+
+var policyTraceflowProtocolNumbers = map[string]int64{
+	"ICMP": 1,
+	"TCP":  6,
+	"UDP":  17,
+}
+
+// SYN flag is set on TCP packets in order to match firewall rules for new connections
+const policyTraceflowTCPFlags int64 = 2
+
+func dataSourceNsxtPolicyTraceflow() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyTraceflowRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": getDataSourceIDSchema(),
+			"segment_port_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of segment port to inject the traceflow packet from",
+				Required:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"src_mac": {
+				Type:         schema.TypeString,
+				Description:  "Source MAC address of the packet",
+				Optional:     true,
+				ValidateFunc: validation.IsMACAddress,
+			},
+			"dst_mac": {
+				Type:         schema.TypeString,
+				Description:  "Destination MAC address of the packet",
+				Optional:     true,
+				ValidateFunc: validation.IsMACAddress,
+			},
+			"src_ip": {
+				Type:         schema.TypeString,
+				Description:  "Source IPv4 address of the packet",
+				Optional:     true,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+			"dst_ip": {
+				Type:         schema.TypeString,
+				Description:  "Destination IPv4 address of the packet",
+				Required:     true,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+			"ttl": {
+				Type:         schema.TypeInt,
+				Description:  "Time to live of the packet",
+				Optional:     true,
+				Default:      64,
+				ValidateFunc: validation.IntBetween(1, 255),
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Description:  "Transport protocol of the packet",
+				Optional:     true,
+				Default:      "ICMP",
+				ValidateFunc: validation.StringInSlice(policyTraceflowProtocolValues, false),
+			},
+			"src_port": {
+				Type:         schema.TypeInt,
+				Description:  "Source port for TCP or UDP packet",
+				Optional:     true,
+				ValidateFunc: validateSinglePort(),
+			},
+			"dst_port": {
+				Type:         schema.TypeInt,
+				Description:  "Destination port for TCP or UDP packet",
+				Optional:     true,
+				ValidateFunc: validateSinglePort(),
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Description:  "Time in seconds to wait for the traceflow to complete",
+				Optional:     true,
+				Default:      60,
+				ValidateFunc: validation.IntAtLeast(5),
+			},
+			"operation_state": {
+				Type:        schema.TypeString,
+				Description: "Final state of the traceflow operation",
+				Computed:    true,
+			},
+			"request_status": {
+				Type:        schema.TypeString,
+				Description: "Status of the traceflow request",
+				Computed:    true,
+			},
+			"delivered": {
+				Type:        schema.TypeBool,
+				Description: "Whether the packet was delivered to its destination",
+				Computed:    true,
+			},
+			"dropped": {
+				Type:        schema.TypeBool,
+				Description: "Whether the packet was dropped",
+				Computed:    true,
+			},
+			"drop_reason": {
+				Type:        schema.TypeString,
+				Description: "Reason the packet was dropped",
+				Computed:    true,
+			},
+			"drop_rule_path": {
+				Type:        schema.TypeString,
+				Description: "Policy path of the firewall rule that dropped the packet",
+				Computed:    true,
+			},
+			"analysis": {
+				Type:        schema.TypeList,
+				Description: "Traceflow result analysis notes",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"observation": {
+				Type:        schema.TypeList,
+				Description: "Observations reported by components along the packet path",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Description: "Type of the observation",
+							Computed:    true,
+						},
+						"sequence_no": {
+							Type:        schema.TypeInt,
+							Description: "Hop count of the observation",
+							Computed:    true,
+						},
+						"component_name": {
+							Type:        schema.TypeString,
+							Description: "Name of the component that issued the observation",
+							Computed:    true,
+						},
+						"component_type": {
+							Type:        schema.TypeString,
+							Description: "Type of the component that issued the observation",
+							Computed:    true,
+						},
+						"component_sub_type": {
+							Type:        schema.TypeString,
+							Description: "Sub type of the component that issued the observation",
+							Computed:    true,
+						},
+						"transport_node_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the transport node that observed the packet",
+							Computed:    true,
+						},
+						"port_name": {
+							Type:        schema.TypeString,
+							Description: "Name of the logical port the observation refers to",
+							Computed:    true,
+						},
+						"acl_rule_id": {
+							Type:        schema.TypeInt,
+							Description: "ID of the firewall rule applied to the packet",
+							Computed:    true,
+						},
+						"reason": {
+							Type:        schema.TypeString,
+							Description: "Reason the packet was dropped",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func policyTraceflowPacketFromSchema(d *schema.ResourceData) (*data.StructValue, error) {
+	protocol := d.Get("protocol").(string)
+	protocolNumber := policyTraceflowProtocolNumbers[protocol]
+	ttl := int64(d.Get("ttl").(int))
+	dstIP := d.Get("dst_ip").(string)
+	srcIP := d.Get("src_ip").(string)
+	srcMac := d.Get("src_mac").(string)
+	dstMac := d.Get("dst_mac").(string)
+	srcPort := int64(d.Get("src_port").(int))
+	dstPort := int64(d.Get("dst_port").(int))
+
+	ipHeader := model.Ipv4Header{
+		DstIp:    &dstIP,
+		Protocol: &protocolNumber,
+		Ttl:      &ttl,
+	}
+	if srcIP != "" {
+		ipHeader.SrcIp = &srcIP
+	}
+
+	transportHeader := model.TransportProtocolHeader{}
+	switch protocol {
+	case "TCP":
+		tcpFlags := policyTraceflowTCPFlags
+		transportHeader.TcpHeader = &model.TcpHeader{
+			SrcPort:  &srcPort,
+			DstPort:  &dstPort,
+			TcpFlags: &tcpFlags,
+		}
+	case "UDP":
+		transportHeader.UdpHeader = &model.UdpHeader{
+			SrcPort: &srcPort,
+			DstPort: &dstPort,
+		}
+	default:
+		transportHeader.IcmpEchoRequestHeader = &model.IcmpEchoRequestHeader{}
+	}
+
+	transportType := model.PacketData_TRANSPORT_TYPE_UNICAST
+	packet := model.FieldsPacketData{
+		ResourceType:    model.PacketData_RESOURCE_TYPE_FIELDSPACKETDATA,
+		IpHeader:        &ipHeader,
+		TransportHeader: &transportHeader,
+		TransportType:   &transportType,
+	}
+
+	if srcMac != "" || dstMac != "" {
+		ethHeader := model.EthernetHeader{}
+		if srcMac != "" {
+			ethHeader.SrcMac = &srcMac
+		}
+		if dstMac != "" {
+			ethHeader.DstMac = &dstMac
+		}
+		packet.EthHeader = &ethHeader
+	}
+
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+	dataValue, errs := converter.ConvertToVapi(packet, model.FieldsPacketDataBindingType())
+	if errs != nil {
+		return nil, errs[0]
+	}
+
+	return dataValue.(*data.StructValue), nil
+}
+
+func policyTraceflowGetRulePath(connector *client.RestConnector, ruleID int64) string {
+	resourceType := "Rule"
+	query := fmt.Sprintf("rule_id:%d", ruleID)
+	results, err := listPolicyResourcesByType(connector, false, &resourceType, &query)
+	if err != nil || len(results) == 0 {
+		log.Printf("[WARNING] Failed to find policy path for rule %d: %v", ruleID, err)
+		return ""
+	}
+
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+	dataValue, errs := converter.ConvertToGolang(results[0], gm_model.PolicyResourceBindingType())
+	if errs != nil {
+		return ""
+	}
+	rule := dataValue.(gm_model.PolicyResource)
+	if rule.Path == nil {
+		return ""
+	}
+
+	return *rule.Path
+}
+
+func setPolicyTraceflowObservationsInSchema(d *schema.ResourceData, connector *client.RestConnector, observations []*data.StructValue) error {
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+
+	var observationList []map[string]interface{}
+	delivered := false
+	dropped := false
+	dropReason := ""
+	var dropRuleID int64
+	for _, obsValue := range observations {
+		dataValue, errs := converter.ConvertToGolang(obsValue, model.TraceflowObservationBindingType())
+		if errs != nil {
+			return errs[0]
+		}
+		obs := dataValue.(model.TraceflowObservation)
+
+		elem := make(map[string]interface{})
+		elem["type"] = obs.ResourceType
+		elem["sequence_no"] = obs.SequenceNo
+		elem["component_name"] = obs.ComponentName
+		elem["component_type"] = obs.ComponentType
+		elem["component_sub_type"] = obs.ComponentSubType
+		elem["transport_node_id"] = obs.TransportNodeId
+
+		switch obs.ResourceType {
+		case model.TraceflowObservation_RESOURCE_TYPE_TRACEFLOWOBSERVATIONDELIVERED:
+			delivered = true
+			dataValue, errs = converter.ConvertToGolang(obsValue, model.TraceflowObservationDeliveredBindingType())
+			if errs != nil {
+				return errs[0]
+			}
+			deliveredObs := dataValue.(model.TraceflowObservationDelivered)
+			elem["port_name"] = deliveredObs.LportName
+		case model.TraceflowObservation_RESOURCE_TYPE_TRACEFLOWOBSERVATIONDROPPED, model.TraceflowObservation_RESOURCE_TYPE_TRACEFLOWOBSERVATIONDROPPEDLOGICAL:
+			dropped = true
+			dataValue, errs = converter.ConvertToGolang(obsValue, model.TraceflowObservationDroppedBindingType())
+			if errs != nil {
+				return errs[0]
+			}
+			droppedObs := dataValue.(model.TraceflowObservationDropped)
+			elem["port_name"] = droppedObs.LportName
+			elem["acl_rule_id"] = droppedObs.AclRuleId
+			elem["reason"] = droppedObs.Reason
+			if droppedObs.Reason != nil {
+				dropReason = *droppedObs.Reason
+			}
+			if droppedObs.AclRuleId != nil {
+				dropRuleID = *droppedObs.AclRuleId
+			}
+		case model.TraceflowObservation_RESOURCE_TYPE_TRACEFLOWOBSERVATIONFORWARDEDLOGICAL:
+			dataValue, errs = converter.ConvertToGolang(obsValue, model.TraceflowObservationForwardedLogicalBindingType())
+			if errs != nil {
+				return errs[0]
+			}
+			forwardedObs := dataValue.(model.TraceflowObservationForwardedLogical)
+			elem["port_name"] = forwardedObs.LportName
+			elem["acl_rule_id"] = forwardedObs.AclRuleId
+		}
+
+		observationList = append(observationList, elem)
+	}
+
+	d.Set("delivered", delivered)
+	d.Set("dropped", dropped)
+	d.Set("drop_reason", dropReason)
+	if dropRuleID > 0 {
+		d.Set("drop_rule_path", policyTraceflowGetRulePath(connector, dropRuleID))
+	} else {
+		d.Set("drop_rule_path", "")
+	}
+
+	return d.Set("observation", observationList)
+}
+
+func dataSourceNsxtPolicyTraceflowRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+	// Data sources have no diff to customize, thus version is checked on read
+	err := checkMinNsxVersion(m, "3.0.0", "data source")
+	if err != nil {
+		return err
+	}
+
+	connector := getPolicyConnector(m)
+	segmentPortPath := d.Get("segment_port_path").(string)
+	timeout := d.Get("timeout").(int)
+
+	packet, err := policyTraceflowPacketFromSchema(d)
+	if err != nil {
+		return fmt.Errorf("Failed to build traceflow packet: %w", err)
+	}
+
+	// Traceflow is a one time operation, hence new ID is generated on each read
+	id := newUUID()
+	obj := model.TraceflowConfig{
+		SegmentPortPath: &segmentPortPath,
+		Packet:          packet,
+	}
+
+	client := infra.NewDefaultTraceflowsClient(connector)
+	log.Printf("[INFO] Starting Traceflow with ID %s from %s", id, segmentPortPath)
+	err = client.Patch(id, obj)
+	if err != nil {
+		return handleCreateError("Traceflow", id, err)
+	}
+
+	defer func() {
+		// Traceflow objects are not needed once observations are collected
+		err := client.Delete(id)
+		if err != nil {
+			log.Printf("[WARNING] Failed to delete Traceflow %s: %v", id, err)
+		}
+	}()
+
+	enforcementPointPath := getPolicyEnforcementPointPath(m)
+	statusClient := traceflows.NewDefaultStatusClient(connector)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{model.Traceflow_OPERATION_STATE_IN_PROGRESS},
+		Target:  []string{model.Traceflow_OPERATION_STATE_FINISHED, model.Traceflow_OPERATION_STATE_FAILED},
+		Refresh: func() (interface{}, string, error) {
+			status, err := statusClient.Get(id, &enforcementPointPath)
+			if err != nil {
+				return status, model.Traceflow_OPERATION_STATE_FAILED, logAPIError("Error while waiting for Traceflow to complete", err)
+			}
+
+			if status.OperationState == nil {
+				return status, model.Traceflow_OPERATION_STATE_IN_PROGRESS, nil
+			}
+			log.Printf("[DEBUG] Current state for Traceflow %s is %s", id, *status.OperationState)
+			return status, *status.OperationState, nil
+		},
+		Timeout:    time.Duration(timeout) * time.Second,
+		MinTimeout: 1 * time.Second,
+		Delay:      1 * time.Second,
+	}
+	statusObj, err := stateConf.WaitForStateContext(getProviderContext(m))
+	if err != nil {
+		return fmt.Errorf("Failed to complete Traceflow from %s: %w", segmentPortPath, err)
+	}
+	status := statusObj.(model.Traceflow)
+
+	d.SetId(id)
+	d.Set("operation_state", status.OperationState)
+	d.Set("request_status", status.RequestStatus)
+	d.Set("analysis", status.Analysis)
+
+	observationsClient := traceflows.NewDefaultObservationsClient(connector)
+	observations, err := observationsClient.List(id, &enforcementPointPath)
+	if err != nil {
+		return handleDataSourceReadError(d, "Traceflow Observations", id, err)
+	}
+
+	log.Printf("[DEBUG] Traceflow %s returned %d observations", id, len(observations.Results))
+	return setPolicyTraceflowObservationsInSchema(d, connector, observations.Results)
+}
@@ -0,0 +1,55 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyTraceflow_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_traceflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+			testAccNSXVersion(t, "3.0.0")
+			testAccEnvDefined(t, "NSXT_TEST_SEGMENT_PORT_PATH")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTraceflowTemplate("ICMP", 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "operation_state", "FINISHED"),
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "delivered"),
+					resource.TestCheckResourceAttrSet(testResourceName, "observation.#"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyTraceflowTemplate("TCP", 443),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "operation_state", "FINISHED"),
+					resource.TestCheckResourceAttr(testResourceName, "protocol", "TCP"),
+					resource.TestCheckResourceAttrSet(testResourceName, "observation.0.component_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyTraceflowTemplate(protocol string, dstPort int) string {
+	return fmt.Sprintf(`
+data "nsxt_policy_traceflow" "test" {
+  segment_port_path = "%s"
+  dst_ip            = "8.8.8.8"
+  protocol          = "%s"
+  src_port          = 12345
+  dst_port          = %d
+}`, getTestSegmentPortPath(), protocol, dstPort)
+}
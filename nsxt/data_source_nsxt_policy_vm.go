@@ -52,7 +52,7 @@ func dataSourceNsxtPolicyVMIDRead(d *schema.ResourceData, m interface{}) error {
 	if objID != "" {
 		vmObj, err := findNsxtPolicyVMByID(connector, objID, m)
 		if err != nil {
-			return fmt.Errorf("Error while reading Virtual Machine %s: %v", objID, err)
+			return fmt.Errorf("Error while reading Virtual Machine %s: %w", objID, err)
 		}
 		vmModel = vmObj
 	} else {
@@ -0,0 +1,133 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyVMs() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyVMsRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": getDataSourceIDSchema(),
+			"display_name_regex": {
+				Type:         schema.TypeString,
+				Description:  "Regular expression to filter Virtual Machines by display name",
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"tag_scope": getPolicyObjectTagScopeSchema(),
+			"tag_value": getPolicyObjectTagValueSchema(),
+			"ids": {
+				Type:        schema.TypeMap,
+				Description: "Mapping of display name to external ID",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"items": {
+				Type:        schema.TypeList,
+				Description: "Matching Virtual Machines",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Description: "External ID of the Virtual Machine",
+							Computed:    true,
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Description: "Display name of the Virtual Machine",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func policyVMMatchesTagFilter(vm model.VirtualMachine, scope string, value string) bool {
+	if scope == "" && value == "" {
+		return true
+	}
+	for _, tag := range vm.Tags {
+		if scope != "" && (tag.Scope == nil || *tag.Scope != scope) {
+			continue
+		}
+		if value != "" && (tag.Tag == nil || *tag.Tag != value) {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+func dataSourceNsxtPolicyVMsRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	var nameRegex *regexp.Regexp
+	if regex := d.Get("display_name_regex").(string); regex != "" {
+		nameRegex = regexp.MustCompile(regex)
+	}
+	tagScope := d.Get("tag_scope").(string)
+	tagValue := d.Get("tag_value").(string)
+
+	allVMs, err := listAllPolicyVirtualMachines(connector, m)
+	if err != nil {
+		return fmt.Errorf("Error while reading Virtual Machines: %w", err)
+	}
+
+	var ids []string
+	namesByID := make(map[string]string)
+	for _, vm := range allVMs {
+		if vm.ExternalId == nil {
+			continue
+		}
+		name := ""
+		if vm.DisplayName != nil {
+			name = *vm.DisplayName
+		}
+		if nameRegex != nil && !nameRegex.MatchString(name) {
+			continue
+		}
+		if !policyVMMatchesTagFilter(vm, tagScope, tagValue) {
+			continue
+		}
+
+		if _, ok := namesByID[*vm.ExternalId]; ok {
+			continue
+		}
+		ids = append(ids, *vm.ExternalId)
+		namesByID[*vm.ExternalId] = name
+	}
+
+	keys := getUniqueDisplayNameKeys(namesByID)
+	sort.Strings(ids)
+	idMap := make(map[string]string)
+	var items []interface{}
+	for _, id := range ids {
+		idMap[keys[id]] = id
+		items = append(items, map[string]interface{}{
+			"id":           id,
+			"display_name": namesByID[id],
+		})
+	}
+
+	d.Set("ids", idMap)
+	d.Set("items", items)
+	d.SetId(newUUID())
+
+	return nil
+}
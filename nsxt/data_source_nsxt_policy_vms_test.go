@@ -0,0 +1,44 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyVMs_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_vms.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccEnvDefined(t, "NSXT_TEST_VM_ID")
+			testAccEnvDefined(t, "NSXT_TEST_VM_NAME")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyVMsReadTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "ids.%", "1"),
+					resource.TestCheckResourceAttr(testResourceName, fmt.Sprintf("ids.%s", getTestVMName()), getTestVMID()),
+					resource.TestCheckResourceAttr(testResourceName, "items.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "items.0.id", getTestVMID()),
+					resource.TestCheckResourceAttr(testResourceName, "items.0.display_name", getTestVMName()),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyVMsReadTemplate() string {
+	return fmt.Sprintf(`
+data "nsxt_policy_vms" "test" {
+  display_name_regex = "^%s$"
+}`, regexp.QuoteMeta(getTestVMName()))
+}
@@ -50,7 +50,7 @@ func dataSourceNsxtPolicyVniPoolRead(d *schema.ResourceData, m interface{}) erro
 		}
 
 		if err != nil {
-			return fmt.Errorf("Error while reading VniPoolConfig %s: %v", objID, err)
+			return fmt.Errorf("Error while reading VniPoolConfig %s: %w", objID, err)
 		}
 		obj = objGet
 	} else if objName == "" {
@@ -60,7 +60,7 @@ func dataSourceNsxtPolicyVniPoolRead(d *schema.ResourceData, m interface{}) erro
 		includeMarkForDeleteObjectsParam := false
 		objList, err := client.List(nil, &includeMarkForDeleteObjectsParam, nil, nil, nil, nil)
 		if err != nil {
-			return fmt.Errorf("Error while reading VniPoolConfigs: %v", err)
+			return fmt.Errorf("Error while reading VniPoolConfigs: %w", err)
 		}
 		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
 		var perfectMatch []model.VniPoolConfig
@@ -278,7 +278,7 @@ func initChildLocaleService(serviceStruct *model.LocaleServices, markForDelete b
 	return dataValue.(*data.StructValue), nil
 }
 
-func initGatewayLocaleServices(d *schema.ResourceData, connector *client.RestConnector, listLocaleServicesFunc func(*client.RestConnector, string, bool) ([]model.LocaleServices, error)) ([]*data.StructValue, error) {
+func initGatewayLocaleServices(d *schema.ResourceData, m interface{}, connector *client.RestConnector, listLocaleServicesFunc func(*client.RestConnector, string, bool) ([]model.LocaleServices, error)) ([]*data.StructValue, error) {
 	var localeServices []*data.StructValue
 
 	services := d.Get("locale_service").(*schema.Set).List()
@@ -320,7 +320,7 @@ func initGatewayLocaleServices(d *schema.ResourceData, connector *client.RestCon
 		if redistribution != nil {
 			redistributionConfigs := redistribution.([]interface{})
 			if len(redistributionConfigs) > 0 {
-				setLocaleServiceRedistributionConfig(redistributionConfigs, &serviceStruct)
+				setLocaleServiceRedistributionConfig(m, redistributionConfigs, &serviceStruct)
 				d.Set("redistribution_set", true)
 			} else {
 				d.Set("redistribution_set", false)
@@ -509,7 +509,7 @@ func setLocaleServiceRedistributionRulesConfig(rulesConfig []interface{}, config
 	}
 }
 
-func setLocaleServiceRedistributionConfig(redistributionConfigs []interface{}, serviceStruct *model.LocaleServices) {
+func setLocaleServiceRedistributionConfig(m interface{}, redistributionConfigs []interface{}, serviceStruct *model.LocaleServices) {
 	if len(redistributionConfigs) == 0 {
 		return
 	}
@@ -523,7 +523,7 @@ func setLocaleServiceRedistributionConfig(redistributionConfigs []interface{}, s
 		BgpEnabled: &bgpEnabled,
 	}
 
-	if nsxVersionHigherOrEqual("3.1.0") {
+	if nsxVersionHigherOrEqual(m, "3.1.0") {
 		redistributionStruct.OspfEnabled = &ospfEnabled
 	}
 
@@ -0,0 +1,214 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vmware/go-vmware-nsxt/administration"
+)
+
+const nsxtHostHealthCheckTimeout = 30 * time.Second
+
+// Manager hosts of a single provider instance. All API calls are directed to
+// the active host. Once active host fails, the next healthy host becomes
+// active for the rest of provider run.
+type nsxtHostFailover struct {
+	mutex sync.Mutex
+	// Serializes failover attempts, not held while sending requests
+	failoverMutex sync.Mutex
+	hosts         []string
+	active        int
+	// Health checks are sent directly to the candidate host, bypassing
+	// session authentication and failover, since both may need the failed
+	// host to be replaced first
+	healthCheckTransport http.RoundTripper
+	username             string
+	password             string
+	remoteAuth           bool
+}
+
+// Returns host with optional port, without scheme
+func getNsxtHostAddress(host string) (string, error) {
+	if !strings.HasPrefix(host, "https://") {
+		host = fmt.Sprintf("https://%s", host)
+	}
+
+	hostURL, err := url.Parse(host)
+	if err != nil {
+		return "", err
+	}
+	if hostURL.Host == "" {
+		return "", fmt.Errorf("Invalid NSX manager host %s", host)
+	}
+
+	return hostURL.Host, nil
+}
+
+func newNsxtHostFailover(hosts []string, healthCheckTransport http.RoundTripper, username string, password string, remoteAuth bool) (*nsxtHostFailover, error) {
+	var addresses []string
+	for _, host := range hosts {
+		address, err := getNsxtHostAddress(host)
+		if err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, address)
+	}
+
+	return &nsxtHostFailover{
+		hosts:                addresses,
+		healthCheckTransport: healthCheckTransport,
+		username:             username,
+		password:             password,
+		remoteAuth:           remoteAuth,
+	}, nil
+}
+
+func (f *nsxtHostFailover) getActiveHost() string {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	return f.hosts[f.active]
+}
+
+func (f *nsxtHostFailover) checkHealth(ctx context.Context, host string) error {
+	ctx, cancel := context.WithTimeout(ctx, nsxtHostHealthCheckTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("https://%s/api/v1/cluster/status", host), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if f.username != "" {
+		// Client certificate, if configured, is presented by the transport
+		auth := base64.StdEncoding.EncodeToString([]byte(f.username + ":" + f.password))
+		if f.remoteAuth {
+			req.Header.Set("Authorization", fmt.Sprintf("Remote %s", auth))
+		} else {
+			req.Header.Set("Authorization", fmt.Sprintf("Basic %s", auth))
+		}
+	}
+
+	resp, err := f.healthCheckTransport.RoundTrip(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("Unexpected status %d", resp.StatusCode)
+	}
+
+	var status administration.ClusterStatus
+	err = json.NewDecoder(resp.Body).Decode(&status)
+	if err != nil {
+		return fmt.Errorf("Failed to decode cluster status: %v", err)
+	}
+	if status.MgmtClusterStatus != nil {
+		mgmtStatus := status.MgmtClusterStatus.Status
+		if mgmtStatus != "STABLE" && mgmtStatus != "DEGRADED" {
+			return fmt.Errorf("Management cluster status is %s", mgmtStatus)
+		}
+	}
+
+	return nil
+}
+
+// Switches to next healthy host after failure of given host, and returns
+// the new active host. If failover was already performed by concurrent
+// request, current active host is returned.
+func (f *nsxtHostFailover) failover(ctx context.Context, failedHost string) (string, bool) {
+	f.failoverMutex.Lock()
+	defer f.failoverMutex.Unlock()
+
+	f.mutex.Lock()
+	active := f.active
+	f.mutex.Unlock()
+
+	if f.hosts[active] != failedHost {
+		return f.hosts[active], true
+	}
+
+	for i := 1; i < len(f.hosts); i++ {
+		candidate := (active + i) % len(f.hosts)
+		err := f.checkHealth(ctx, f.hosts[candidate])
+		if err != nil {
+			log.Printf("[WARNING] NSX manager %s is not healthy: %v", f.hosts[candidate], err)
+			continue
+		}
+
+		log.Printf("[INFO] NSX manager %s failed, switching to %s", failedHost, f.hosts[candidate])
+		f.mutex.Lock()
+		f.active = candidate
+		f.mutex.Unlock()
+		return f.hosts[candidate], true
+	}
+
+	log.Printf("[ERROR] No healthy NSX manager found to replace %s", failedHost)
+	return "", false
+}
+
+type hostFailoverTransport struct {
+	transport http.RoundTripper
+	failover  *nsxtHostFailover
+}
+
+func newHostFailoverTransport(transport http.RoundTripper, failover *nsxtHostFailover) *hostFailoverTransport {
+	return &hostFailoverTransport{transport: transport, failover: failover}
+}
+
+func (t *hostFailoverTransport) hostRequest(req *http.Request, host string, body bool) (*http.Request, error) {
+	hostReq := req.Clone(req.Context())
+	if body && req.GetBody != nil {
+		var err error
+		hostReq.Body, err = req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+	}
+	hostReq.URL.Host = host
+	hostReq.Host = host
+	return hostReq, nil
+}
+
+func (t *hostFailoverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	host := t.failover.getActiveHost()
+	hostReq, err := t.hostRequest(req, host, false)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.transport.RoundTrip(hostReq)
+	if err == nil && resp.StatusCode != http.StatusServiceUnavailable {
+		return resp, nil
+	}
+
+	if req.Context().Err() != nil || (req.Body != nil && req.GetBody == nil) {
+		return resp, err
+	}
+
+	newHost, ok := t.failover.failover(req.Context(), host)
+	if !ok {
+		return resp, err
+	}
+
+	retryReq, retryErr := t.hostRequest(req, newHost, true)
+	if retryErr != nil {
+		return resp, err
+	}
+
+	if resp != nil {
+		resp.Body.Close()
+	}
+	return t.transport.RoundTrip(retryReq)
+}
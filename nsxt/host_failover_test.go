@@ -0,0 +1,193 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// NSX manager node that serves session, cluster status and API calls, or
+// fails all requests with 503
+type testFailoverServer struct {
+	server        *httptest.Server
+	mutex         sync.Mutex
+	paths         []string
+	failing       bool
+	clusterStatus string
+}
+
+func newTestFailoverServer(failing bool, clusterStatus string) *testFailoverServer {
+	s := &testFailoverServer{failing: failing, clusterStatus: clusterStatus}
+	s.server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mutex.Lock()
+		s.paths = append(s.paths, r.URL.Path)
+		s.mutex.Unlock()
+
+		if s.failing {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/api/session/create":
+			http.SetCookie(w, &http.Cookie{Name: nsxtSessionCookieName, Value: "s1"})
+		case "/api/v1/cluster/status":
+			username, password, ok := r.BasicAuth()
+			if !ok || username != "admin" || password != "secret" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			fmt.Fprintf(w, `{"mgmt_cluster_status": {"status": "%s"}}`, s.clusterStatus)
+		default:
+			if cookie, err := r.Cookie(nsxtSessionCookieName); err != nil || cookie.Value != "s1" {
+				w.WriteHeader(http.StatusForbidden)
+			}
+		}
+	}))
+	return s
+}
+
+func (s *testFailoverServer) getPaths() []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]string{}, s.paths...)
+}
+
+func (s *testFailoverServer) address() string {
+	return strings.TrimPrefix(s.server.URL, "https://")
+}
+
+func newTestFailoverTransport() http.RoundTripper {
+	return &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
+}
+
+// Builds session authenticated client transport on top of host failover,
+// same as provider does with session_auth and failover_hosts
+func newTestSessionFailoverTransport(t *testing.T, primary *testFailoverServer, secondary *testFailoverServer) (*sessionAuthTransport, *nsxtHostFailover) {
+	tr := newTestFailoverTransport()
+	failover, err := newNsxtHostFailover([]string{primary.server.URL, secondary.address()}, tr, "admin", "secret", false)
+	if err != nil {
+		t.Fatalf("Failed to configure failover: %v", err)
+	}
+	transport := newHostFailoverTransport(tr, failover)
+	session := newNsxtSession(primary.server.URL, "admin", "secret", false, transport)
+	return newSessionAuthTransport(transport, session), failover
+}
+
+// Sends request and fails the test if it does not complete in time, which
+// indicates a deadlock
+func testFailoverRoundTrip(t *testing.T, transport http.RoundTripper, req *http.Request) int {
+	type result struct {
+		status int
+		err    error
+	}
+	done := make(chan result, 1)
+	go func() {
+		resp, err := transport.RoundTrip(req)
+		if err != nil {
+			done <- result{err: err}
+			return
+		}
+		resp.Body.Close()
+		done <- result{status: resp.StatusCode}
+	}()
+
+	select {
+	case r := <-done:
+		if r.err != nil {
+			t.Fatalf("Unexpected error: %v", r.err)
+		}
+		return r.status
+	case <-time.After(10 * time.Second):
+		t.Fatalf("Request did not complete, failover is stuck")
+	}
+	return 0
+}
+
+func TestHostFailoverWithSessionAuth(t *testing.T) {
+	primary := newTestFailoverServer(true, "")
+	defer primary.server.Close()
+	secondary := newTestFailoverServer(false, "STABLE")
+	defer secondary.server.Close()
+	transport, failover := newTestSessionFailoverTransport(t, primary, secondary)
+
+	req, _ := http.NewRequest("GET", primary.server.URL+"/api/v1/node", nil)
+	status := testFailoverRoundTrip(t, transport, req)
+	if status != http.StatusOK {
+		t.Errorf("Expected status %d after failover, got %d", http.StatusOK, status)
+	}
+	if failover.getActiveHost() != secondary.address() {
+		t.Errorf("Expected active host %s, got %s", secondary.address(), failover.getActiveHost())
+	}
+
+	// Session creation failed over, health check did not need a session
+	expected := "/api/v1/cluster/status,/api/session/create,/api/v1/node"
+	if paths := strings.Join(secondary.getPaths(), ","); paths != expected {
+		t.Errorf("Expected calls %s on healthy host, got %s", expected, paths)
+	}
+	if paths := primary.getPaths(); len(paths) != 1 || paths[0] != "/api/session/create" {
+		t.Errorf("Expected single session create attempt on failed host, got %v", paths)
+	}
+}
+
+func TestHostFailoverNoHealthyHost(t *testing.T) {
+	primary := newTestFailoverServer(true, "")
+	defer primary.server.Close()
+	secondary := newTestFailoverServer(false, "UNSTABLE")
+	defer secondary.server.Close()
+
+	tr := newTestFailoverTransport()
+	failover, err := newNsxtHostFailover([]string{primary.server.URL, secondary.server.URL}, tr, "admin", "secret", false)
+	if err != nil {
+		t.Fatalf("Failed to configure failover: %v", err)
+	}
+	transport := newHostFailoverTransport(tr, failover)
+
+	req, _ := http.NewRequest("GET", primary.server.URL+"/api/v1/node", nil)
+	status := testFailoverRoundTrip(t, transport, req)
+	if status != http.StatusServiceUnavailable {
+		t.Errorf("Expected status %d of failed host, got %d", http.StatusServiceUnavailable, status)
+	}
+	if failover.getActiveHost() != primary.address() {
+		t.Errorf("Expected active host to remain %s, got %s", primary.address(), failover.getActiveHost())
+	}
+}
+
+func TestHostFailoverCheckHealth(t *testing.T) {
+	cases := []struct {
+		clusterStatus string
+		username      string
+		healthy       bool
+	}{
+		{"STABLE", "admin", true},
+		{"DEGRADED", "admin", true},
+		{"UNSTABLE", "admin", false},
+		{"STABLE", "other", false},
+	}
+
+	for _, tc := range cases {
+		server := newTestFailoverServer(false, tc.clusterStatus)
+		failover, err := newNsxtHostFailover([]string{server.server.URL}, newTestFailoverTransport(), tc.username, "secret", false)
+		if err != nil {
+			t.Fatalf("Failed to configure failover: %v", err)
+		}
+
+		err = failover.checkHealth(context.Background(), server.address())
+		if tc.healthy && err != nil {
+			t.Errorf("%s as %s: expected healthy host, got %v", tc.clusterStatus, tc.username, err)
+		}
+		if !tc.healthy && err == nil {
+			t.Errorf("%s as %s: expected unhealthy host", tc.clusterStatus, tc.username)
+		}
+		server.server.Close()
+	}
+}
@@ -0,0 +1,116 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/net/http/httpproxy"
+)
+
+var providerTLSVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// HTTP settings that apply to all connections of a provider instance: MP
+// and policy clients, NSX session and VMC token exchange
+type providerHTTPConfig struct {
+	proxy          func(*url.URL) (*url.URL, error)
+	tlsMinVersion  uint16
+	connectTimeout time.Duration
+	idleTimeout    time.Duration
+	customHeaders  map[string]string
+}
+
+func newProviderHTTPConfig(d *schema.ResourceData) (*providerHTTPConfig, error) {
+	// Proxy settings from environment are overridden by provider settings
+	proxyConfig := httpproxy.FromEnvironment()
+	if proxyURL := d.Get("proxy_url").(string); proxyURL != "" {
+		_, err := url.Parse(proxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid proxy_url %s: %v", proxyURL, err)
+		}
+		proxyConfig.HTTPProxy = proxyURL
+		proxyConfig.HTTPSProxy = proxyURL
+	}
+	if noProxy := d.Get("no_proxy").(string); noProxy != "" {
+		proxyConfig.NoProxy = noProxy
+	}
+
+	config := providerHTTPConfig{
+		proxy:          proxyConfig.ProxyFunc(),
+		connectTimeout: time.Duration(d.Get("connect_timeout").(int)) * time.Second,
+		idleTimeout:    time.Duration(d.Get("idle_timeout").(int)) * time.Second,
+		customHeaders:  make(map[string]string),
+	}
+
+	if version := d.Get("tls_min_version").(string); version != "" {
+		tlsVersion, ok := providerTLSVersions[version]
+		if !ok {
+			return nil, fmt.Errorf("Unsupported tls_min_version %s", version)
+		}
+		config.tlsMinVersion = tlsVersion
+	}
+
+	for name, value := range d.Get("custom_headers").(map[string]interface{}) {
+		config.customHeaders[http.CanonicalHeaderKey(name)] = value.(string)
+	}
+
+	return &config, nil
+}
+
+// Returns base transport for connections with given TLS configuration
+func (c *providerHTTPConfig) newTransport(tlsConfig *tls.Config) *http.Transport {
+	tlsConfig = tlsConfig.Clone()
+	if c.tlsMinVersion != 0 {
+		tlsConfig.MinVersion = c.tlsMinVersion
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   c.connectTimeout,
+		KeepAlive: 30 * time.Second,
+	}
+
+	return &http.Transport{
+		Proxy: func(req *http.Request) (*url.URL, error) {
+			return c.proxy(req.URL)
+		},
+		DialContext:         dialer.DialContext,
+		TLSClientConfig:     tlsConfig,
+		TLSHandshakeTimeout: c.connectTimeout,
+		IdleConnTimeout:     c.idleTimeout,
+		MaxIdleConns:        100,
+		MaxIdleConnsPerHost: 100,
+	}
+}
+
+// Adds headers from provider configuration to each request
+type customHeadersTransport struct {
+	transport http.RoundTripper
+	headers   map[string]string
+}
+
+func newCustomHeadersTransport(transport http.RoundTripper, headers map[string]string) http.RoundTripper {
+	if len(headers) == 0 {
+		return transport
+	}
+	return &customHeadersTransport{transport: transport, headers: headers}
+}
+
+func (t *customHeadersTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	headersReq := req.Clone(req.Context())
+	for name, value := range t.headers {
+		headersReq.Header.Set(name, value)
+	}
+	return t.transport.RoundTrip(headersReq)
+}
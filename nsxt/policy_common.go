@@ -21,6 +21,11 @@ var gatewayPolicyCategoryValues = []string{"Emergency", "SystemRules", "SharedPr
 var policyFailOverModeValues = []string{model.Tier1_FAILOVER_MODE_PREEMPTIVE, model.Tier1_FAILOVER_MODE_NON_PREEMPTIVE}
 var failOverModeDefaultPolicyT0Value = model.Tier0_FAILOVER_MODE_NON_PREEMPTIVE
 var defaultPolicyLocaleServiceID = "default"
+var policyRulePathReferences = []policyPathReference{
+	{attribute: "rule.source_groups", resourceTypes: []string{"Group"}},
+	{attribute: "rule.destination_groups", resourceTypes: []string{"Group"}},
+	{attribute: "rule.services", resourceTypes: []string{"Service"}},
+}
 
 func getNsxIDSchema() *schema.Schema {
 	return &schema.Schema{
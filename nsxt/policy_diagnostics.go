@@ -0,0 +1,255 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/lib/vapi/std/errors"
+)
+
+// Remediation hints for well known NSX error codes
+var policyErrorCodeRemediations = map[int64]string{
+	500030: "The object is in use by other objects. Remove the references to it, for example from rules, groups or gateways, or destroy the referring resources first.",
+}
+
+var policyErrorTypeRemediations = map[errors.ErrorType]string{
+	errors.ErrorType_UNAUTHENTICATED:     "Verify credentials in provider configuration.",
+	errors.ErrorType_UNAUTHORIZED:        "Verify that NSX user configured for the provider has a role that permits this operation.",
+	errors.ErrorType_SERVICE_UNAVAILABLE: "NSX manager is not available. Consider configuring retries or failover_hosts in provider configuration.",
+}
+
+// NSX attributes that are named differently in terraform schema
+var policyErrorAttributeNames = map[string]string{
+	"rules":   "rule",
+	"subnets": "subnet",
+	"tags":    "tag",
+}
+
+// Matches references to nested attributes, such as rules[3].destination_groups
+var policyErrorAttributeRegexp = regexp.MustCompile(`\b([a-z][a-z0-9_]*)\[(\d+)\]\.([a-z][a-z0-9_]*)\b`)
+
+func (e *policyAPIError) getSummary() string {
+	return strings.TrimSpace(fmt.Sprintf("%s: %s", e.message, printAPIError(e.apiError)))
+}
+
+func (e *policyAPIError) getDetail() string {
+	var lines []string
+	if e.apiError.ErrorCode != nil {
+		line := fmt.Sprintf("Error code: %d", *e.apiError.ErrorCode)
+		if e.apiError.ModuleName != nil {
+			line += fmt.Sprintf(" (module %s)", *e.apiError.ModuleName)
+		}
+		lines = append(lines, line)
+	}
+	if e.vapiType != nil {
+		lines = append(lines, fmt.Sprintf("Error type: %s", *e.vapiType))
+	}
+	if e.apiError.Details != nil && *e.apiError.Details != "" {
+		lines = append(lines, fmt.Sprintf("Details: %s", *e.apiError.Details))
+	}
+	if e.errorData != "" {
+		lines = append(lines, fmt.Sprintf("Error data: %s", e.errorData))
+	}
+	if len(e.apiError.RelatedErrors) > 0 {
+		lines = append(lines, "Related errors:")
+		for _, relatedErr := range e.apiError.RelatedErrors {
+			line := fmt.Sprintf("  - %s", printRelatedAPIError(relatedErr))
+			if relatedErr.ModuleName != nil {
+				line += fmt.Sprintf(" (module %s)", *relatedErr.ModuleName)
+			}
+			lines = append(lines, line)
+		}
+	}
+	if remediation := e.getRemediation(); remediation != "" {
+		lines = append(lines, fmt.Sprintf("Remediation: %s", remediation))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (e *policyAPIError) getRemediation() string {
+	codes := []*int64{e.apiError.ErrorCode}
+	for _, relatedErr := range e.apiError.RelatedErrors {
+		codes = append(codes, relatedErr.ErrorCode)
+	}
+	for _, code := range codes {
+		if code == nil {
+			continue
+		}
+		if remediation, ok := policyErrorCodeRemediations[*code]; ok {
+			return remediation
+		}
+	}
+
+	if e.vapiType != nil {
+		return policyErrorTypeRemediations[*e.vapiType]
+	}
+	return ""
+}
+
+// Returns path of the attribute the error refers to, if the attribute is
+// present in resource schema
+func (e *policyAPIError) getAttributePath(resourceSchema map[string]*schema.Schema) cty.Path {
+	if resourceSchema == nil {
+		return nil
+	}
+
+	messages := []*string{e.apiError.ErrorMessage, e.apiError.Details}
+	for _, relatedErr := range e.apiError.RelatedErrors {
+		messages = append(messages, relatedErr.ErrorMessage, relatedErr.Details)
+	}
+
+	for _, message := range messages {
+		if message == nil {
+			continue
+		}
+		for _, match := range policyErrorAttributeRegexp.FindAllStringSubmatch(*message, -1) {
+			index, err := strconv.Atoi(match[2])
+			if err != nil {
+				continue
+			}
+			if path := getPolicyErrorAttributePath(resourceSchema, match[1], index, match[3]); path != nil {
+				return path
+			}
+		}
+	}
+
+	return nil
+}
+
+// Returns schema attribute for NSX attribute, which is either named same way
+// or is a singular block
+func getPolicyErrorSchemaAttribute(resourceSchema map[string]*schema.Schema, name string) (string, *schema.Schema) {
+	if attrSchema, ok := resourceSchema[name]; ok {
+		return name, attrSchema
+	}
+	if schemaName, ok := policyErrorAttributeNames[name]; ok {
+		if attrSchema, ok := resourceSchema[schemaName]; ok {
+			return schemaName, attrSchema
+		}
+	}
+	return "", nil
+}
+
+func getPolicyErrorAttributePath(resourceSchema map[string]*schema.Schema, listName string, index int, attrName string) cty.Path {
+	name, listSchema := getPolicyErrorSchemaAttribute(resourceSchema, listName)
+	if listSchema == nil {
+		return nil
+	}
+
+	// Position in NSX list matches terraform index only for lists, since
+	// sets are ordered by hash
+	path := cty.GetAttrPath(name)
+	if listSchema.Type != schema.TypeList {
+		return path
+	}
+	elem, ok := listSchema.Elem.(*schema.Resource)
+	if !ok {
+		return path
+	}
+
+	nestedName, nestedSchema := getPolicyErrorSchemaAttribute(elem.Schema, attrName)
+	if nestedSchema == nil {
+		return path.IndexInt(index)
+	}
+	return path.IndexInt(index).GetAttr(nestedName)
+}
+
+// Converts error into diagnostics, with NSX error details when available
+func getErrorDiagnostics(err error) diag.Diagnostics {
+	return getResourceErrorDiagnostics(err, nil)
+}
+
+// Converts error of resource or data source operation into diagnostics,
+// pointing to the attribute NSX error refers to
+func getResourceErrorDiagnostics(err error, resourceSchema map[string]*schema.Schema) diag.Diagnostics {
+	if err == nil {
+		return nil
+	}
+
+	var apiErr *policyAPIError
+	if !stderrors.As(err, &apiErr) {
+		return diag.FromErr(err)
+	}
+
+	summary := apiErr.getSummary()
+	if wrapped := err.Error(); err != error(apiErr) && strings.HasSuffix(wrapped, apiErr.Error()) {
+		// Keep context added by wrapping errors
+		summary = fmt.Sprintf("%s %s", strings.TrimSpace(strings.TrimSuffix(wrapped, apiErr.Error())), summary)
+	}
+
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       summary,
+			Detail:        apiErr.getDetail(),
+			AttributePath: apiErr.getAttributePath(resourceSchema),
+		},
+	}
+}
+
+// Warnings collected during CRUD operation, keyed by resource data
+var resourceWarnings sync.Map
+
+// Reports non-fatal problem as warning diagnostic of current CRUD operation
+func addResourceWarning(d *schema.ResourceData, summary string, detail string) {
+	log.Printf("[WARNING] %s: %s", summary, detail)
+	if warnings, ok := resourceWarnings.Load(d); ok {
+		diags := warnings.(*diag.Diagnostics)
+		*diags = append(*diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  summary,
+			Detail:   detail,
+		})
+	}
+}
+
+// Runs CRUD function with operation context available via provider meta,
+// and reports errors and warnings as diagnostics
+func wrapResourceFunc(f func(*schema.ResourceData, interface{}) error, resourceSchema map[string]*schema.Schema) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		var warnings diag.Diagnostics
+		resourceWarnings.Store(d, &warnings)
+		defer resourceWarnings.Delete(d)
+
+		err := f(d, withProviderContext(ctx, m))
+		return append(warnings, getResourceErrorDiagnostics(err, resourceSchema)...)
+	}
+}
+
+// Resources and data sources implement CRUD with meta-based functions,
+// which are converted to context-aware functions. This is the intended way
+// to write resources in this provider, including new ones: the wrapper
+// threads operation context into meta and converts errors to diagnostics
+// with attribute paths and warnings, so that resources need not deal with
+// either.
+func wrapResourceContextFuncs(r *schema.Resource) {
+	if r.Create != nil {
+		r.CreateContext = wrapResourceFunc(r.Create, r.Schema)
+		r.Create = nil
+	}
+	if r.Read != nil {
+		r.ReadContext = wrapResourceFunc(r.Read, r.Schema)
+		r.Read = nil
+	}
+	if r.Update != nil {
+		r.UpdateContext = wrapResourceFunc(r.Update, r.Schema)
+		r.Update = nil
+	}
+	if r.Delete != nil {
+		r.DeleteContext = wrapResourceFunc(r.Delete, r.Schema)
+		r.Delete = nil
+	}
+}
@@ -0,0 +1,216 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/lib/vapi/std/errors"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func testPolicyDiagnosticsSchema() map[string]*schema.Schema {
+	nested := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"display_name":       {Type: schema.TypeString, Optional: true},
+			"destination_groups": {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			"tag":                {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		},
+	}
+	return map[string]*schema.Schema{
+		"display_name": {Type: schema.TypeString, Optional: true},
+		"rule":         {Type: schema.TypeList, Optional: true, Elem: nested},
+		"tag":          {Type: schema.TypeSet, Optional: true, Elem: nested},
+		"members":      {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+	}
+}
+
+func testPolicyAPIError(code int64, message string, details string, relatedErrors ...model.RelatedApiError) *policyAPIError {
+	apiError := model.ApiError{
+		ErrorCode:     &code,
+		ErrorMessage:  &message,
+		RelatedErrors: relatedErrors,
+	}
+	if details != "" {
+		apiError.Details = &details
+	}
+	return newPolicyAPIError("Failed to update Test test", nil, apiError)
+}
+
+func testRelatedAPIError(code int64, message string) model.RelatedApiError {
+	return model.RelatedApiError{ErrorCode: &code, ErrorMessage: &message}
+}
+
+func TestPolicyAPIErrorGetAttributePath(t *testing.T) {
+	cases := []struct {
+		name     string
+		apiErr   *policyAPIError
+		expected cty.Path
+	}{
+		{
+			name:     "renamed list",
+			apiErr:   testPolicyAPIError(1, "Invalid path in rules[2].destination_groups", ""),
+			expected: cty.GetAttrPath("rule").IndexInt(2).GetAttr("destination_groups"),
+		},
+		{
+			name:     "renamed nested attribute",
+			apiErr:   testPolicyAPIError(1, "Invalid value of rules[0].tags", ""),
+			expected: cty.GetAttrPath("rule").IndexInt(0).GetAttr("tag"),
+		},
+		{
+			name:     "set is not indexed",
+			apiErr:   testPolicyAPIError(1, "Invalid value of tags[1].scope", ""),
+			expected: cty.GetAttrPath("tag"),
+		},
+		{
+			name:     "nested attribute not in schema",
+			apiErr:   testPolicyAPIError(1, "Invalid value of rules[1].sequence_number", ""),
+			expected: cty.GetAttrPath("rule").IndexInt(1),
+		},
+		{
+			name:     "list of primitives",
+			apiErr:   testPolicyAPIError(1, "Invalid value of members[1].path", ""),
+			expected: cty.GetAttrPath("members"),
+		},
+		{
+			name:     "attribute not in schema",
+			apiErr:   testPolicyAPIError(1, "Invalid value of subnets[0].cidr", ""),
+			expected: nil,
+		},
+		{
+			name:     "second reference in schema",
+			apiErr:   testPolicyAPIError(1, "Invalid value of services[0].path and rules[3].display_name", ""),
+			expected: cty.GetAttrPath("rule").IndexInt(3).GetAttr("display_name"),
+		},
+		{
+			name:     "details",
+			apiErr:   testPolicyAPIError(1, "Invalid rule", "Invalid path in rules[4].destination_groups"),
+			expected: cty.GetAttrPath("rule").IndexInt(4).GetAttr("destination_groups"),
+		},
+		{
+			name:     "related error",
+			apiErr:   testPolicyAPIError(1, "Invalid policy", "", testRelatedAPIError(2, "Invalid value of rules[5].display_name")),
+			expected: cty.GetAttrPath("rule").IndexInt(5).GetAttr("display_name"),
+		},
+		{
+			name:     "no reference",
+			apiErr:   testPolicyAPIError(1, "Object not found", ""),
+			expected: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		result := tc.apiErr.getAttributePath(testPolicyDiagnosticsSchema())
+		if !result.Equals(tc.expected) {
+			t.Errorf("%s: expected path %#v, got %#v", tc.name, tc.expected, result)
+		}
+	}
+
+	apiErr := testPolicyAPIError(1, "Invalid path in rules[2].destination_groups", "")
+	if result := apiErr.getAttributePath(nil); result != nil {
+		t.Errorf("Expected no path without schema, got %#v", result)
+	}
+}
+
+func TestPolicyAPIErrorGetRemediation(t *testing.T) {
+	unauthenticated := errors.ErrorType_UNAUTHENTICATED
+	notFound := errors.ErrorType_NOT_FOUND
+	message := "error"
+	cases := []struct {
+		name     string
+		apiErr   *policyAPIError
+		expected string
+	}{
+		{
+			name:     "error code",
+			apiErr:   testPolicyAPIError(500030, "In use", ""),
+			expected: policyErrorCodeRemediations[500030],
+		},
+		{
+			name:     "related error code",
+			apiErr:   testPolicyAPIError(1, "Failed", "", testRelatedAPIError(2, "Other"), testRelatedAPIError(500030, "In use")),
+			expected: policyErrorCodeRemediations[500030],
+		},
+		{
+			name:     "error type",
+			apiErr:   newPolicyAPIError("Failed", &unauthenticated, model.ApiError{ErrorMessage: &message}),
+			expected: policyErrorTypeRemediations[errors.ErrorType_UNAUTHENTICATED],
+		},
+		{
+			name:     "error code takes precedence",
+			apiErr:   newPolicyAPIError("Failed", &unauthenticated, testPolicyAPIError(500030, "In use", "").apiError),
+			expected: policyErrorCodeRemediations[500030],
+		},
+		{
+			name:     "unknown error type",
+			apiErr:   newPolicyAPIError("Failed", &notFound, model.ApiError{ErrorMessage: &message}),
+			expected: "",
+		},
+		{
+			name:     "unknown error code",
+			apiErr:   testPolicyAPIError(1, "Failed", ""),
+			expected: "",
+		},
+	}
+
+	for _, tc := range cases {
+		result := tc.apiErr.getRemediation()
+		if result != tc.expected {
+			t.Errorf("%s: expected remediation %q, got %q", tc.name, tc.expected, result)
+		}
+	}
+}
+
+func TestGetErrorDiagnostics(t *testing.T) {
+	if diags := getErrorDiagnostics(nil); diags != nil {
+		t.Errorf("Expected no diagnostics without error, got %v", diags)
+	}
+
+	diags := getErrorDiagnostics(fmt.Errorf("plain error"))
+	if len(diags) != 1 || diags[0].Severity != diag.Error || diags[0].Summary != "plain error" || diags[0].Detail != "" {
+		t.Errorf("Expected plain error diagnostic, got %v", diags)
+	}
+
+	apiErr := testPolicyAPIError(500030, "Object is in use", "Referenced by rules[1].destination_groups", testRelatedAPIError(2, "Related problem"))
+	diags = getErrorDiagnostics(apiErr)
+	if len(diags) != 1 {
+		t.Fatalf("Expected single diagnostic, got %v", diags)
+	}
+	if diags[0].Summary != "Failed to update Test test: Object is in use (code 500030)" {
+		t.Errorf("Unexpected summary %q", diags[0].Summary)
+	}
+	for _, line := range []string{"Error code: 500030", "Details: Referenced by rules[1].destination_groups", "  - Related problem (code 2)", "Remediation: "} {
+		if !strings.Contains(diags[0].Detail, line) {
+			t.Errorf("Expected %q in detail, got %q", line, diags[0].Detail)
+		}
+	}
+	if diags[0].AttributePath != nil {
+		t.Errorf("Expected no attribute path without schema, got %#v", diags[0].AttributePath)
+	}
+}
+
+func TestGetResourceErrorDiagnosticsWrapped(t *testing.T) {
+	apiErr := testPolicyAPIError(1, "Invalid path", "Invalid path in rules[1].destination_groups")
+	err := fmt.Errorf("Error during policy update: %w", apiErr)
+
+	diags := getResourceErrorDiagnostics(err, testPolicyDiagnosticsSchema())
+	if len(diags) != 1 {
+		t.Fatalf("Expected single diagnostic, got %v", diags)
+	}
+	if diags[0].Summary != "Error during policy update: Failed to update Test test: Invalid path (code 1)" {
+		t.Errorf("Expected summary with wrapping context, got %q", diags[0].Summary)
+	}
+	if !strings.Contains(diags[0].Detail, "Error code: 1") {
+		t.Errorf("Expected NSX error details, got %q", diags[0].Detail)
+	}
+	expected := cty.GetAttrPath("rule").IndexInt(1).GetAttr("destination_groups")
+	if !diags[0].AttributePath.Equals(expected) {
+		t.Errorf("Expected attribute path %#v, got %#v", expected, diags[0].AttributePath)
+	}
+}
@@ -16,6 +16,44 @@ import (
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 )
 
+// Error returned by NSX API, with NSX error details preserved for
+// diagnostics
+type policyAPIError struct {
+	message   string
+	vapiType  *errors.ErrorType
+	apiError  model.ApiError
+	errorData string
+}
+
+func (e *policyAPIError) Error() string {
+	details := fmt.Sprintf(" %s: %s", e.message, printAPIError(e.apiError))
+
+	if len(e.apiError.RelatedErrors) > 0 {
+		details += "\nRelated errors:\n"
+		for _, relatedErr := range e.apiError.RelatedErrors {
+			details += fmt.Sprintf("%s ", printRelatedAPIError(relatedErr))
+		}
+	}
+	return details
+}
+
+func newPolicyAPIError(message string, vapiType *errors.ErrorType, apiError model.ApiError) *policyAPIError {
+	apiErr := policyAPIError{
+		message:  message,
+		vapiType: vapiType,
+		apiError: apiError,
+	}
+
+	if apiError.ErrorData != nil {
+		errorData, err := cleanjson.NewDataValueToJsonEncoder().Encode(apiError.ErrorData)
+		if err == nil {
+			apiErr.errorData = errorData
+		}
+	}
+
+	return &apiErr
+}
+
 func isEmptyAPIError(apiError model.ApiError) bool {
 	return (apiError.ErrorCode == nil && apiError.ErrorMessage == nil)
 }
@@ -93,18 +131,9 @@ func logVapiErrorData(message string, vapiMessages []std.LocalizableMessage, vap
 		return logRawVapiErrorData(message, vapiType, apiErrorDataValue)
 	}
 
-	apiError := data.(model.ApiError)
-
-	details := fmt.Sprintf(" %s: %s", message, printAPIError(apiError))
-
-	if len(apiError.RelatedErrors) > 0 {
-		details += "\nRelated errors:\n"
-		for _, relatedErr := range apiError.RelatedErrors {
-			details += fmt.Sprintf("%s ", printRelatedAPIError(relatedErr))
-		}
-	}
-	log.Printf("[ERROR]: %s", details)
-	return fmt.Errorf(details)
+	apiErr := newPolicyAPIError(message, vapiType, data.(model.ApiError))
+	log.Printf("[ERROR]: %s", apiErr.Error())
+	return apiErr
 }
 
 func logAPIError(message string, err error) error {
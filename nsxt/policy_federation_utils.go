@@ -0,0 +1,198 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	gm_infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+// Full sync of large configuration may take a while
+const policyFullSyncDefaultTimeout = 30 * time.Minute
+
+var fullSyncPendingStates = []string{
+	gm_model.FullSyncState_LAST_COMPLETED_STAGE_INITIAL,
+	gm_model.FullSyncState_LAST_COMPLETED_STAGE_PAUSE_DCNS,
+	gm_model.FullSyncState_LAST_COMPLETED_STAGE_DELETED_STALE_ENTITIES,
+	gm_model.FullSyncState_LAST_COMPLETED_STAGE_PROCESSED_FULLSYNC_DATA,
+	gm_model.FullSyncState_LAST_COMPLETED_STAGE_PROCESSED_DELTAS,
+	gm_model.FullSyncState_LAST_COMPLETED_STAGE_UNPAUSE_DCNS,
+}
+
+var fullSyncFailedStates = []string{
+	gm_model.FullSyncState_LAST_COMPLETED_STAGE_ERROR,
+	gm_model.FullSyncState_LAST_COMPLETED_STAGE_ABORTED,
+}
+
+// Federation objects only exist in Global Manager model, hence tags need
+// to be converted from local model used by common helpers
+func getPolicyGlobalManagerTagsFromSchema(d *schema.ResourceData) []gm_model.Tag {
+	var tags []gm_model.Tag
+	for _, tag := range getPolicyTagsFromSchema(d) {
+		tags = append(tags, gm_model.Tag{Scope: tag.Scope, Tag: tag.Tag})
+	}
+	return tags
+}
+
+func setPolicyGlobalManagerTagsInSchema(d *schema.ResourceData, gmTags []gm_model.Tag) {
+	var tags []model.Tag
+	for _, tag := range gmTags {
+		tags = append(tags, model.Tag{Scope: tag.Scope, Tag: tag.Tag})
+	}
+	setPolicyTagsInSchema(d, tags)
+}
+
+func getPolicySiteConnectionInfoSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Optional:    true,
+		MaxItems:    3,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"fqdn": {
+					Type:        schema.TypeString,
+					Description: "FQDN or IP address of the NSX manager",
+					Required:    true,
+				},
+				"username": {
+					Type:        schema.TypeString,
+					Description: "Username of the NSX manager",
+					Optional:    true,
+				},
+				"password": {
+					Type:        schema.TypeString,
+					Description: "Password of the NSX manager",
+					Optional:    true,
+					Sensitive:   true,
+				},
+				"thumbprint": {
+					Type:        schema.TypeString,
+					Description: "SHA256 thumbprint of the NSX manager API certificate",
+					Optional:    true,
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func getPolicyMaximumRttSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Description:  "Maximum acceptable packet round trip time in milliseconds",
+		Optional:     true,
+		Default:      250,
+		ValidateFunc: validation.IntBetween(0, 1000),
+	}
+}
+
+func getPolicyFailIfRttExceededSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Fail onboarding if maximum round trip time exceeds threshold",
+		Optional:    true,
+		Default:     true,
+	}
+}
+
+func getPolicySiteConnectionInfoFromSchema(d *schema.ResourceData, attrName string) []gm_model.SiteNodeConnectionInfo {
+	var infoList []gm_model.SiteNodeConnectionInfo
+	for _, info := range d.Get(attrName).([]interface{}) {
+		data := info.(map[string]interface{})
+		fqdn := data["fqdn"].(string)
+		elem := gm_model.SiteNodeConnectionInfo{
+			Fqdn: &fqdn,
+		}
+		if username := data["username"].(string); username != "" {
+			elem.Username = &username
+		}
+		if password := data["password"].(string); password != "" {
+			elem.Password = &password
+		}
+		if thumbprint := data["thumbprint"].(string); thumbprint != "" {
+			elem.Thumbprint = &thumbprint
+		}
+
+		infoList = append(infoList, elem)
+	}
+
+	return infoList
+}
+
+func setPolicySiteConnectionInfoInSchema(d *schema.ResourceData, attrName string, infoList []gm_model.SiteNodeConnectionInfo) {
+	// Password is not returned by NSX, hence it is preserved from intent
+	passwords := make(map[string]interface{})
+	for _, info := range d.Get(attrName).([]interface{}) {
+		data := info.(map[string]interface{})
+		passwords[data["fqdn"].(string)] = data["password"]
+	}
+
+	var result []map[string]interface{}
+	for _, info := range infoList {
+		elem := make(map[string]interface{})
+		elem["fqdn"] = info.Fqdn
+		elem["username"] = info.Username
+		elem["thumbprint"] = info.Thumbprint
+		if info.Fqdn != nil {
+			elem["password"] = passwords[*info.Fqdn]
+		}
+
+		result = append(result, elem)
+	}
+
+	d.Set(attrName, result)
+}
+
+// Onboarding of a site or standby Global Manager triggers full sync of
+// configuration, which is tracked by full sync state with same ID
+func policyWaitForFullSync(m interface{}, id string, timeout time.Duration) error {
+	client := gm_infra.NewDefaultFullSyncStatesClient(getPolicyConnector(m))
+	targetStates := []string{gm_model.FullSyncState_LAST_COMPLETED_STAGE_COMPLETED}
+	stateConf := &resource.StateChangeConf{
+		Pending: fullSyncPendingStates,
+		Target:  append(targetStates, fullSyncFailedStates...),
+		Refresh: func() (interface{}, string, error) {
+			state, err := client.Get(id)
+			if isNotFoundError(err) {
+				// Full sync did not start yet
+				return state, gm_model.FullSyncState_LAST_COMPLETED_STAGE_INITIAL, nil
+			}
+			if err != nil {
+				return state, "", logAPIError("Error retrieving full sync state", err)
+			}
+
+			if state.LastCompletedStage == nil {
+				return state, gm_model.FullSyncState_LAST_COMPLETED_STAGE_INITIAL, nil
+			}
+
+			log.Printf("[DEBUG] Full sync %s is at stage %s", id, *state.LastCompletedStage)
+			return state, *state.LastCompletedStage, nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+		Delay:      5 * time.Second,
+	}
+
+	result, err := stateConf.WaitForStateContext(getProviderContext(m))
+	if err != nil {
+		return fmt.Errorf("Failed to wait for full sync of %s: %w", id, err)
+	}
+
+	state := result.(gm_model.FullSyncState)
+	if *state.LastCompletedStage != gm_model.FullSyncState_LAST_COMPLETED_STAGE_COMPLETED {
+		return fmt.Errorf("Full sync of %s failed at stage %s: %s", id, *state.LastCompletedStage, strings.Join(state.Errors, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,245 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var lbSslCipherGroupLabelValues = []string{
+	model.LBClientSslProfile_CIPHER_GROUP_LABEL_BALANCED,
+	model.LBClientSslProfile_CIPHER_GROUP_LABEL_HIGH_SECURITY,
+	model.LBClientSslProfile_CIPHER_GROUP_LABEL_HIGH_COMPATIBILITY,
+	model.LBClientSslProfile_CIPHER_GROUP_LABEL_CUSTOM,
+}
+
+func getPolicyLbSslCipherGroupLabelSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "Label of cipher group, ciphers are only configurable for CUSTOM label",
+		Optional:     true,
+		Default:      model.LBClientSslProfile_CIPHER_GROUP_LABEL_BALANCED,
+		ValidateFunc: validation.StringInSlice(lbSslCipherGroupLabelValues, false),
+	}
+}
+
+func getPolicyLbSslIsFipsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "This flag is set to true when all the ciphers and protocols are FIPS compliant",
+		Computed:    true,
+	}
+}
+
+// App, monitor and persistence profiles are polymorphic in policy API, hence
+// all profile types of same family share Exists and Delete implementation
+
+func policyLbProfileToStructValue(obj interface{}, bindingType bindings.BindingType) (*data.StructValue, error) {
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+
+	dataValue, errs := converter.ConvertToVapi(obj, bindingType)
+	if errs != nil {
+		return nil, errs[0]
+	}
+
+	return dataValue.(*data.StructValue), nil
+}
+
+func policyLbProfileFromStructValue(obj *data.StructValue, bindingType bindings.BindingType) (interface{}, error) {
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+
+	result, errs := converter.ConvertToGolang(obj, bindingType)
+	if errs != nil {
+		return nil, errs[0]
+	}
+
+	return result, nil
+}
+
+// TCP and UDP monitor profiles share the MP schema, with policy specific
+// identification attributes
+func getPolicyLbL4MonitorProfileSchema(protocol string) map[string]*schema.Schema {
+	result := getLbL4MonitorSchema(protocol)
+	result["nsx_id"] = getNsxIDSchema()
+	result["path"] = getPathSchema()
+	result["display_name"] = getDisplayNameSchema()
+	result["description"] = getDescriptionSchema()
+
+	return result
+}
+
+func getPolicyLbMonitorPortFromSchema(d *schema.ResourceData) *int64 {
+	portStr := d.Get("monitor_port").(string)
+	if portStr == "" {
+		return nil
+	}
+
+	// Value is validated by the schema
+	port, _ := strconv.ParseInt(portStr, 10, 64)
+	return &port
+}
+
+func setPolicyLbMonitorPortInSchema(d *schema.ResourceData, port *int64) {
+	if port == nil {
+		d.Set("monitor_port", "")
+		return
+	}
+
+	d.Set("monitor_port", strconv.FormatInt(*port, 10))
+}
+
+func getPolicyLbHTTPHeaderFromSchema(d *schema.ResourceData, attrName string) []model.LbHttpRequestHeader {
+	headers := d.Get(attrName).(*schema.Set).List()
+	var headerList []model.LbHttpRequestHeader
+	for _, header := range headers {
+		data := header.(map[string]interface{})
+		name := data["name"].(string)
+		value := data["value"].(string)
+		elem := model.LbHttpRequestHeader{
+			HeaderName:  &name,
+			HeaderValue: &value,
+		}
+
+		headerList = append(headerList, elem)
+	}
+	return headerList
+}
+
+func setPolicyLbHTTPHeaderInSchema(d *schema.ResourceData, attrName string, headers []model.LbHttpRequestHeader) {
+	var headerList []map[string]interface{}
+	for _, header := range headers {
+		elem := make(map[string]interface{})
+		elem["name"] = header.HeaderName
+		elem["value"] = header.HeaderValue
+		headerList = append(headerList, elem)
+	}
+	d.Set(attrName, headerList)
+}
+
+func getPolicyLbResponseStatusCodesFromSchema(d *schema.ResourceData) []int64 {
+	var codes []int64
+	for _, code := range d.Get("response_status_codes").([]interface{}) {
+		codes = append(codes, int64(code.(int)))
+	}
+	return codes
+}
+
+func resourceNsxtPolicyLBAppProfileExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	client := infra.NewDefaultLbAppProfilesClient(connector)
+
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving LBAppProfile", err)
+}
+
+func resourceNsxtPolicyLBAppProfileDelete(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBAppProfile ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewDefaultLbAppProfilesClient(connector)
+	force := true
+	err := client.Delete(id, &force)
+	if err != nil {
+		return handleDeleteError("LBAppProfile", id, err)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyLBMonitorProfileExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	client := infra.NewDefaultLbMonitorProfilesClient(connector)
+
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving LBMonitorProfile", err)
+}
+
+func resourceNsxtPolicyLBMonitorProfileDelete(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBMonitorProfile ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewDefaultLbMonitorProfilesClient(connector)
+	force := true
+	err := client.Delete(id, &force)
+	if err != nil {
+		return handleDeleteError("LBMonitorProfile", id, err)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyLBPersistenceProfileExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	client := infra.NewDefaultLbPersistenceProfilesClient(connector)
+
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving LBPersistenceProfile", err)
+}
+
+func resourceNsxtPolicyLBPersistenceProfileDelete(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBPersistenceProfile ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewDefaultLbPersistenceProfilesClient(connector)
+	force := true
+	err := client.Delete(id, &force)
+	if err != nil {
+		return handleDeleteError("LBPersistenceProfile", id, err)
+	}
+
+	return nil
+}
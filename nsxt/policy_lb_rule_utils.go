@@ -0,0 +1,1362 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var lbRulePhaseValues = []string{
+	model.LBRule_PHASE_HTTP_REQUEST_REWRITE,
+	model.LBRule_PHASE_HTTP_FORWARDING,
+	model.LBRule_PHASE_HTTP_RESPONSE_REWRITE,
+	model.LBRule_PHASE_HTTP_ACCESS,
+	model.LBRule_PHASE_TRANSPORT,
+}
+
+var lbRuleMatchStrategyValues = []string{
+	model.LBRule_MATCH_STRATEGY_ALL,
+	model.LBRule_MATCH_STRATEGY_ANY,
+}
+
+var lbRuleHTTPRequestMethodValues = []string{
+	model.LBHttpRequestMethodCondition_METHOD_GET,
+	model.LBHttpRequestMethodCondition_METHOD_OPTIONS,
+	model.LBHttpRequestMethodCondition_METHOD_POST,
+	model.LBHttpRequestMethodCondition_METHOD_HEAD,
+	model.LBHttpRequestMethodCondition_METHOD_PUT,
+}
+
+var lbRuleHTTPVersionValues = []string{
+	model.LBHttpRequestVersionCondition_VERSION_0,
+	model.LBHttpRequestVersionCondition_VERSION_1,
+}
+
+var lbRuleSslSessionReusedValues = []string{
+	model.LBHttpSslCondition_SESSION_REUSED_IGNORE,
+	model.LBHttpSslCondition_SESSION_REUSED_REUSED,
+	model.LBHttpSslCondition_SESSION_REUSED_NEW,
+}
+
+var lbRuleSslUsedProtocolValues = []string{
+	model.LBHttpSslCondition_USED_PROTOCOL_SSL_V2,
+	model.LBHttpSslCondition_USED_PROTOCOL_SSL_V3,
+	model.LBHttpSslCondition_USED_PROTOCOL_TLS_V1,
+	model.LBHttpSslCondition_USED_PROTOCOL_TLS_V1_1,
+	model.LBHttpSslCondition_USED_PROTOCOL_TLS_V1_2,
+}
+
+var lbRuleSslModeValues = []string{
+	model.LBSslModeSelectionAction_SSL_MODE_PASSTHROUGH,
+	model.LBSslModeSelectionAction_SSL_MODE_END_TO_END,
+	model.LBSslModeSelectionAction_SSL_MODE_OFFLOAD,
+}
+
+func getPolicyLbRuleRequiredStringSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: description,
+		Required:    true,
+	}
+}
+
+func getPolicyLbRuleOptionalStringSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: description,
+		Optional:    true,
+	}
+}
+
+// Schema for conditions that match a single string value with match type and case sensitivity
+func getPolicyLbRuleMatchConditionSchema(description string, attrs map[string]*schema.Schema) *schema.Schema {
+	elemSchema := map[string]*schema.Schema{
+		"inverse":        getLbRuleInverseSchema(),
+		"case_sensitive": getLbRuleCaseSensitiveSchema(),
+		"match_type":     getLbRuleMatchTypeSchema(),
+	}
+	for key, value := range attrs {
+		elemSchema[key] = value
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: elemSchema,
+		},
+	}
+}
+
+func getPolicyLbRuleConditionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Conditions to match application traffic",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"http_request_body": getPolicyLbRuleMatchConditionSchema("Rule condition based on http request body", map[string]*schema.Schema{
+					"body_value": getPolicyLbRuleRequiredStringSchema("Http request body string to match"),
+				}),
+				"http_request_cookie": getPolicyLbRuleMatchConditionSchema("Rule condition based on http request cookie", map[string]*schema.Schema{
+					"cookie_name":  getPolicyLbRuleRequiredStringSchema("Name of cookie"),
+					"cookie_value": getPolicyLbRuleRequiredStringSchema("Value of cookie"),
+				}),
+				"http_request_header": getPolicyLbRuleMatchConditionSchema("Rule condition based on http request header", map[string]*schema.Schema{
+					"header_name":  getPolicyLbRuleRequiredStringSchema("Name of http request header"),
+					"header_value": getPolicyLbRuleRequiredStringSchema("Value of http request header"),
+				}),
+				"http_request_method": {
+					Type:        schema.TypeList,
+					Description: "Rule condition based on http request method",
+					Optional:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"inverse": getLbRuleInverseSchema(),
+							"method": {
+								Type:         schema.TypeString,
+								Description:  "Http request method",
+								Required:     true,
+								ValidateFunc: validation.StringInSlice(lbRuleHTTPRequestMethodValues, false),
+							},
+						},
+					},
+				},
+				"http_request_uri_arguments": getPolicyLbRuleMatchConditionSchema("Rule condition based on http request URI arguments", map[string]*schema.Schema{
+					"uri_arguments": getPolicyLbRuleRequiredStringSchema("URI arguments, aka query string of Http request messages"),
+				}),
+				"http_request_uri": getPolicyLbRuleMatchConditionSchema("Rule condition based on http request URI", map[string]*schema.Schema{
+					"uri": getPolicyLbRuleRequiredStringSchema("A string used to identify resource"),
+				}),
+				"http_request_version": {
+					Type:        schema.TypeList,
+					Description: "Rule condition based on http request version",
+					Optional:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"inverse": getLbRuleInverseSchema(),
+							"version": {
+								Type:         schema.TypeString,
+								Description:  "Http version",
+								Required:     true,
+								ValidateFunc: validation.StringInSlice(lbRuleHTTPVersionValues, false),
+							},
+						},
+					},
+				},
+				"http_response_header": getPolicyLbRuleMatchConditionSchema("Rule condition based on http response header", map[string]*schema.Schema{
+					"header_name":  getPolicyLbRuleRequiredStringSchema("Name of http response header"),
+					"header_value": getPolicyLbRuleRequiredStringSchema("Value of http response header"),
+				}),
+				"http_ssl": {
+					Type:        schema.TypeList,
+					Description: "Rule condition based on http ssl handshake",
+					Optional:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"inverse":                       getLbRuleInverseSchema(),
+							"client_certificate_issuer_dn":  getPolicyLbRuleSslDnConditionSchema("Match condition for client certificate issuer DN", "issuer_dn"),
+							"client_certificate_subject_dn": getPolicyLbRuleSslDnConditionSchema("Match condition for client certificate subject DN", "subject_dn"),
+							"client_supported_ssl_ciphers": {
+								Type:        schema.TypeList,
+								Description: "Cipher list which supported by client",
+								Optional:    true,
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+							"session_reused": {
+								Type:         schema.TypeString,
+								Description:  "The type of SSL session reused",
+								Optional:     true,
+								Default:      model.LBHttpSslCondition_SESSION_REUSED_IGNORE,
+								ValidateFunc: validation.StringInSlice(lbRuleSslSessionReusedValues, false),
+							},
+							"used_protocol": {
+								Type:         schema.TypeString,
+								Description:  "Protocol of an established SSL connection",
+								Optional:     true,
+								ValidateFunc: validation.StringInSlice(lbRuleSslUsedProtocolValues, false),
+							},
+							"used_ssl_cipher": getPolicyLbRuleOptionalStringSchema("Cipher used for an established SSL connection"),
+						},
+					},
+				},
+				"ip_header": {
+					Type:        schema.TypeList,
+					Description: "Rule condition based on IP settings of the message",
+					Optional:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"inverse": getLbRuleInverseSchema(),
+							"source_address": {
+								Type:         schema.TypeString,
+								Description:  "Source IP address of the packet",
+								Optional:     true,
+								ValidateFunc: validateSingleIP(),
+							},
+							"group_path": getPolicyPathSchema(false, false, "Path of group for source IP addresses to match"),
+						},
+					},
+				},
+				"ssl_sni": getPolicyLbRuleMatchConditionSchema("Rule condition based on SNI in client SSL hello message", map[string]*schema.Schema{
+					"sni": getPolicyLbRuleRequiredStringSchema("Server Name Indication"),
+				}),
+				"tcp_header": {
+					Type:        schema.TypeList,
+					Description: "Rule condition based on TCP settings of the message",
+					Optional:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"inverse": getLbRuleInverseSchema(),
+							"source_port": {
+								Type:         schema.TypeString,
+								Description:  "TCP source port or port range of the packet",
+								Required:     true,
+								ValidateFunc: validatePortRange(),
+							},
+						},
+					},
+				},
+				"variable": getPolicyLbRuleMatchConditionSchema("Rule condition based on value of a variable", map[string]*schema.Schema{
+					"variable_name":  getPolicyLbRuleRequiredStringSchema("Name of the variable to be matched"),
+					"variable_value": getPolicyLbRuleRequiredStringSchema("Value of the variable to be matched"),
+				}),
+			},
+		},
+	}
+}
+
+func getPolicyLbRuleSslDnConditionSchema(description string, attrName string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				attrName:         getPolicyLbRuleRequiredStringSchema("Value of distinguished name"),
+				"case_sensitive": getLbRuleCaseSensitiveSchema(),
+				"match_type":     getLbRuleMatchTypeSchema(),
+			},
+		},
+	}
+}
+
+func getPolicyLbRuleHeaderActionSchema(description string, withValue bool) *schema.Schema {
+	elemSchema := map[string]*schema.Schema{
+		"header_name": getPolicyLbRuleRequiredStringSchema("Name of header"),
+	}
+	if withValue {
+		elemSchema["header_value"] = getPolicyLbRuleRequiredStringSchema("Value of header")
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: elemSchema,
+		},
+	}
+}
+
+func getPolicyLbRuleVariablePersistenceActionSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"variable_name":            getPolicyLbRuleRequiredStringSchema("Variable name"),
+				"persistence_profile_path": getPolicyPathSchema(false, false, "Path to generic persistence profile"),
+				"variable_hash_enabled": {
+					Type:        schema.TypeBool,
+					Description: "Whether to enable a hash operation for variable value",
+					Optional:    true,
+					Default:     false,
+				},
+			},
+		},
+	}
+}
+
+func getPolicyLbRuleActionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Actions to be executed when load balancer rule matches",
+		Required:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"http_redirect": {
+					Type:        schema.TypeList,
+					Description: "Action to redirect http request messages to a new URL",
+					Optional:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"redirect_status": getPolicyLbRuleRequiredStringSchema("Http response status code"),
+							"redirect_url":    getPolicyLbRuleRequiredStringSchema("The URL that the HTTP request is redirected to"),
+						},
+					},
+				},
+				"http_reject": {
+					Type:        schema.TypeList,
+					Description: "Action to reject http request messages",
+					Optional:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"reply_status":  getPolicyLbRuleRequiredStringSchema("Http response status code"),
+							"reply_message": getPolicyLbRuleOptionalStringSchema("Response message"),
+						},
+					},
+				},
+				"http_request_header_delete":   getPolicyLbRuleHeaderActionSchema("Action to delete header fields of http request messages", false),
+				"http_request_header_rewrite":  getPolicyLbRuleHeaderActionSchema("Action to rewrite header fields of http request messages", true),
+				"http_response_header_delete":  getPolicyLbRuleHeaderActionSchema("Action to delete header fields of http response messages", false),
+				"http_response_header_rewrite": getPolicyLbRuleHeaderActionSchema("Action to rewrite header fields of http response messages", true),
+				"http_request_uri_rewrite": {
+					Type:        schema.TypeList,
+					Description: "Action to rewrite URIs in matched http request messages",
+					Optional:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"uri":           getPolicyLbRuleRequiredStringSchema("URI of http request message"),
+							"uri_arguments": getPolicyLbRuleOptionalStringSchema("URI arguments"),
+						},
+					},
+				},
+				"jwt_auth": {
+					Type:        schema.TypeList,
+					Description: "Action to control access to backend server resources using JSON Web Token authentication",
+					Optional:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"key": {
+								Type:        schema.TypeList,
+								Description: "Key to verify signature of JWT token",
+								Optional:    true,
+								MaxItems:    1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"certificate_path":   getPolicyPathSchema(false, false, "Path of certificate to verify JWT signature"),
+										"public_key_content": getPolicyLbRuleOptionalStringSchema("Content of public key"),
+										"symmetric_key": {
+											Type:        schema.TypeBool,
+											Description: "Use symmetric key to verify JWT signature",
+											Optional:    true,
+											Default:     false,
+										},
+									},
+								},
+							},
+							"pass_jwt_to_pool": {
+								Type:        schema.TypeBool,
+								Description: "Whether to pass JWT to backend server",
+								Optional:    true,
+								Default:     false,
+							},
+							"realm": getPolicyLbRuleOptionalStringSchema("Realm of the JWT"),
+							"tokens": {
+								Type:        schema.TypeList,
+								Description: "Names of arguments from which JWT is retrieved when it is not found in Authorization header",
+								Optional:    true,
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+						},
+					},
+				},
+				"select_pool": {
+					Type:        schema.TypeList,
+					Description: "Action to select a pool for matched http request messages",
+					Optional:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"pool_id": getPolicyPathSchema(true, false, "Path of load balancer pool"),
+						},
+					},
+				},
+				"ssl_mode_selection": {
+					Type:        schema.TypeList,
+					Description: "Action to select SSL mode",
+					Optional:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"ssl_mode": {
+								Type:         schema.TypeString,
+								Description:  "Type of SSL mode",
+								Required:     true,
+								ValidateFunc: validation.StringInSlice(lbRuleSslModeValues, false),
+							},
+						},
+					},
+				},
+				"variable_assignment": {
+					Type:        schema.TypeList,
+					Description: "Action to create a new variable and assign value to it",
+					Optional:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"variable_name":  getPolicyLbRuleRequiredStringSchema("Name of the variable to be assigned"),
+							"variable_value": getPolicyLbRuleRequiredStringSchema("Value of variable"),
+						},
+					},
+				},
+				"variable_persistence_learn": getPolicyLbRuleVariablePersistenceActionSchema("Action to learn the value of variable from the HTTP response, and persist it"),
+				"variable_persistence_on":    getPolicyLbRuleVariablePersistenceActionSchema("Action to persist the variable value"),
+			},
+		},
+	}
+}
+
+func getPolicyLbRuleSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "List of load balancer rules to manipulate application traffic, executed in order",
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"display_name": getPolicyLbRuleOptionalStringSchema("Display name of the rule"),
+				"match_strategy": {
+					Type:         schema.TypeString,
+					Description:  "Strategy to match conditions",
+					Optional:     true,
+					Default:      model.LBRule_MATCH_STRATEGY_ALL,
+					ValidateFunc: validation.StringInSlice(lbRuleMatchStrategyValues, false),
+				},
+				"phase": {
+					Type:         schema.TypeString,
+					Description:  "Load balancer processing phase",
+					Optional:     true,
+					Default:      model.LBRule_PHASE_HTTP_FORWARDING,
+					ValidateFunc: validation.StringInSlice(lbRulePhaseValues, false),
+				},
+				"condition": getPolicyLbRuleConditionSchema(),
+				"action":    getPolicyLbRuleActionSchema(),
+			},
+		},
+	}
+}
+
+func convertPolicyLbRuleElemToVapi(converter *bindings.TypeConverter, obj interface{}, bindingType bindings.BindingType, list []*data.StructValue) ([]*data.StructValue, error) {
+	dataValue, errs := converter.ConvertToVapi(obj, bindingType)
+	if errs != nil {
+		return list, errs[0]
+	}
+
+	return append(list, dataValue.(*data.StructValue)), nil
+}
+
+// Common match condition attributes: inverse, case sensitivity and match type
+func getPolicyLbRuleMatchAttrs(data map[string]interface{}) (*bool, *bool, *string) {
+	inverse := data["inverse"].(bool)
+	caseSensitive := data["case_sensitive"].(bool)
+	matchType := data["match_type"].(string)
+	return &inverse, &caseSensitive, &matchType
+}
+
+func getPolicyLbRuleStringAttr(data map[string]interface{}, key string) *string {
+	value := data[key].(string)
+	return &value
+}
+
+func getPolicyLbRuleOptionalStringAttr(data map[string]interface{}, key string) *string {
+	value := data[key].(string)
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+func getPolicyLbRuleConditionsFromSchema(converter *bindings.TypeConverter, conditionMap map[string]interface{}) ([]*data.StructValue, error) {
+	var conditions []*data.StructValue
+	var err error
+
+	for _, item := range conditionMap["http_request_body"].([]interface{}) {
+		data := item.(map[string]interface{})
+		inverse, caseSensitive, matchType := getPolicyLbRuleMatchAttrs(data)
+		obj := model.LBHttpRequestBodyCondition{
+			Type_:         model.LBRuleCondition_TYPE_LBHTTPREQUESTBODYCONDITION,
+			Inverse:       inverse,
+			CaseSensitive: caseSensitive,
+			MatchType:     matchType,
+			BodyValue:     getPolicyLbRuleStringAttr(data, "body_value"),
+		}
+		conditions, err = convertPolicyLbRuleElemToVapi(converter, obj, model.LBHttpRequestBodyConditionBindingType(), conditions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, item := range conditionMap["http_request_cookie"].([]interface{}) {
+		data := item.(map[string]interface{})
+		inverse, caseSensitive, matchType := getPolicyLbRuleMatchAttrs(data)
+		obj := model.LBHttpRequestCookieCondition{
+			Type_:         model.LBRuleCondition_TYPE_LBHTTPREQUESTCOOKIECONDITION,
+			Inverse:       inverse,
+			CaseSensitive: caseSensitive,
+			MatchType:     matchType,
+			CookieName:    getPolicyLbRuleStringAttr(data, "cookie_name"),
+			CookieValue:   getPolicyLbRuleStringAttr(data, "cookie_value"),
+		}
+		conditions, err = convertPolicyLbRuleElemToVapi(converter, obj, model.LBHttpRequestCookieConditionBindingType(), conditions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, item := range conditionMap["http_request_header"].([]interface{}) {
+		data := item.(map[string]interface{})
+		inverse, caseSensitive, matchType := getPolicyLbRuleMatchAttrs(data)
+		obj := model.LBHttpRequestHeaderCondition{
+			Type_:         model.LBRuleCondition_TYPE_LBHTTPREQUESTHEADERCONDITION,
+			Inverse:       inverse,
+			CaseSensitive: caseSensitive,
+			MatchType:     matchType,
+			HeaderName:    getPolicyLbRuleStringAttr(data, "header_name"),
+			HeaderValue:   getPolicyLbRuleStringAttr(data, "header_value"),
+		}
+		conditions, err = convertPolicyLbRuleElemToVapi(converter, obj, model.LBHttpRequestHeaderConditionBindingType(), conditions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, item := range conditionMap["http_request_method"].([]interface{}) {
+		data := item.(map[string]interface{})
+		inverse := data["inverse"].(bool)
+		obj := model.LBHttpRequestMethodCondition{
+			Type_:   model.LBRuleCondition_TYPE_LBHTTPREQUESTMETHODCONDITION,
+			Inverse: &inverse,
+			Method:  getPolicyLbRuleStringAttr(data, "method"),
+		}
+		conditions, err = convertPolicyLbRuleElemToVapi(converter, obj, model.LBHttpRequestMethodConditionBindingType(), conditions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, item := range conditionMap["http_request_uri_arguments"].([]interface{}) {
+		data := item.(map[string]interface{})
+		inverse, caseSensitive, matchType := getPolicyLbRuleMatchAttrs(data)
+		obj := model.LBHttpRequestUriArgumentsCondition{
+			Type_:         model.LBRuleCondition_TYPE_LBHTTPREQUESTURIARGUMENTSCONDITION,
+			Inverse:       inverse,
+			CaseSensitive: caseSensitive,
+			MatchType:     matchType,
+			UriArguments:  getPolicyLbRuleStringAttr(data, "uri_arguments"),
+		}
+		conditions, err = convertPolicyLbRuleElemToVapi(converter, obj, model.LBHttpRequestUriArgumentsConditionBindingType(), conditions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, item := range conditionMap["http_request_uri"].([]interface{}) {
+		data := item.(map[string]interface{})
+		inverse, caseSensitive, matchType := getPolicyLbRuleMatchAttrs(data)
+		obj := model.LBHttpRequestUriCondition{
+			Type_:         model.LBRuleCondition_TYPE_LBHTTPREQUESTURICONDITION,
+			Inverse:       inverse,
+			CaseSensitive: caseSensitive,
+			MatchType:     matchType,
+			Uri:           getPolicyLbRuleStringAttr(data, "uri"),
+		}
+		conditions, err = convertPolicyLbRuleElemToVapi(converter, obj, model.LBHttpRequestUriConditionBindingType(), conditions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, item := range conditionMap["http_request_version"].([]interface{}) {
+		data := item.(map[string]interface{})
+		inverse := data["inverse"].(bool)
+		obj := model.LBHttpRequestVersionCondition{
+			Type_:   model.LBRuleCondition_TYPE_LBHTTPREQUESTVERSIONCONDITION,
+			Inverse: &inverse,
+			Version: getPolicyLbRuleStringAttr(data, "version"),
+		}
+		conditions, err = convertPolicyLbRuleElemToVapi(converter, obj, model.LBHttpRequestVersionConditionBindingType(), conditions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, item := range conditionMap["http_response_header"].([]interface{}) {
+		data := item.(map[string]interface{})
+		inverse, caseSensitive, matchType := getPolicyLbRuleMatchAttrs(data)
+		obj := model.LBHttpResponseHeaderCondition{
+			Type_:         model.LBRuleCondition_TYPE_LBHTTPRESPONSEHEADERCONDITION,
+			Inverse:       inverse,
+			CaseSensitive: caseSensitive,
+			MatchType:     matchType,
+			HeaderName:    getPolicyLbRuleStringAttr(data, "header_name"),
+			HeaderValue:   getPolicyLbRuleStringAttr(data, "header_value"),
+		}
+		conditions, err = convertPolicyLbRuleElemToVapi(converter, obj, model.LBHttpResponseHeaderConditionBindingType(), conditions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, item := range conditionMap["http_ssl"].([]interface{}) {
+		data := item.(map[string]interface{})
+		inverse := data["inverse"].(bool)
+		obj := model.LBHttpSslCondition{
+			Type_:                     model.LBRuleCondition_TYPE_LBHTTPSSLCONDITION,
+			Inverse:                   &inverse,
+			ClientSupportedSslCiphers: interface2StringList(data["client_supported_ssl_ciphers"].([]interface{})),
+			SessionReused:             getPolicyLbRuleOptionalStringAttr(data, "session_reused"),
+			UsedProtocol:              getPolicyLbRuleOptionalStringAttr(data, "used_protocol"),
+			UsedSslCipher:             getPolicyLbRuleOptionalStringAttr(data, "used_ssl_cipher"),
+		}
+		for _, dn := range data["client_certificate_issuer_dn"].([]interface{}) {
+			// maximum count is one
+			dnData := dn.(map[string]interface{})
+			caseSensitive := dnData["case_sensitive"].(bool)
+			obj.ClientCertificateIssuerDn = &model.LBClientCertificateIssuerDnCondition{
+				CaseSensitive: &caseSensitive,
+				MatchType:     getPolicyLbRuleStringAttr(dnData, "match_type"),
+				IssuerDn:      getPolicyLbRuleStringAttr(dnData, "issuer_dn"),
+			}
+		}
+		for _, dn := range data["client_certificate_subject_dn"].([]interface{}) {
+			// maximum count is one
+			dnData := dn.(map[string]interface{})
+			caseSensitive := dnData["case_sensitive"].(bool)
+			obj.ClientCertificateSubjectDn = &model.LBClientCertificateSubjectDnCondition{
+				CaseSensitive: &caseSensitive,
+				MatchType:     getPolicyLbRuleStringAttr(dnData, "match_type"),
+				SubjectDn:     getPolicyLbRuleStringAttr(dnData, "subject_dn"),
+			}
+		}
+		conditions, err = convertPolicyLbRuleElemToVapi(converter, obj, model.LBHttpSslConditionBindingType(), conditions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, item := range conditionMap["ip_header"].([]interface{}) {
+		data := item.(map[string]interface{})
+		inverse := data["inverse"].(bool)
+		obj := model.LBIpHeaderCondition{
+			Type_:         model.LBRuleCondition_TYPE_LBIPHEADERCONDITION,
+			Inverse:       &inverse,
+			SourceAddress: getPolicyLbRuleOptionalStringAttr(data, "source_address"),
+			GroupPath:     getPolicyLbRuleOptionalStringAttr(data, "group_path"),
+		}
+		conditions, err = convertPolicyLbRuleElemToVapi(converter, obj, model.LBIpHeaderConditionBindingType(), conditions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, item := range conditionMap["ssl_sni"].([]interface{}) {
+		data := item.(map[string]interface{})
+		inverse, caseSensitive, matchType := getPolicyLbRuleMatchAttrs(data)
+		obj := model.LBSslSniCondition{
+			Type_:         model.LBRuleCondition_TYPE_LBSSLSNICONDITION,
+			Inverse:       inverse,
+			CaseSensitive: caseSensitive,
+			MatchType:     matchType,
+			Sni:           getPolicyLbRuleStringAttr(data, "sni"),
+		}
+		conditions, err = convertPolicyLbRuleElemToVapi(converter, obj, model.LBSslSniConditionBindingType(), conditions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, item := range conditionMap["tcp_header"].([]interface{}) {
+		data := item.(map[string]interface{})
+		inverse := data["inverse"].(bool)
+		obj := model.LBTcpHeaderCondition{
+			Type_:      model.LBRuleCondition_TYPE_LBTCPHEADERCONDITION,
+			Inverse:    &inverse,
+			SourcePort: getPolicyLbRuleStringAttr(data, "source_port"),
+		}
+		conditions, err = convertPolicyLbRuleElemToVapi(converter, obj, model.LBTcpHeaderConditionBindingType(), conditions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, item := range conditionMap["variable"].([]interface{}) {
+		data := item.(map[string]interface{})
+		inverse, caseSensitive, matchType := getPolicyLbRuleMatchAttrs(data)
+		obj := model.LBVariableCondition{
+			Type_:         model.LBRuleCondition_TYPE_LBVARIABLECONDITION,
+			Inverse:       inverse,
+			CaseSensitive: caseSensitive,
+			MatchType:     matchType,
+			VariableName:  getPolicyLbRuleStringAttr(data, "variable_name"),
+			VariableValue: getPolicyLbRuleStringAttr(data, "variable_value"),
+		}
+		conditions, err = convertPolicyLbRuleElemToVapi(converter, obj, model.LBVariableConditionBindingType(), conditions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return conditions, nil
+}
+
+func getPolicyLbRuleJwtKeyFromSchema(converter *bindings.TypeConverter, keys []interface{}) (*data.StructValue, error) {
+	for _, key := range keys {
+		// maximum count is one
+		keyData := key.(map[string]interface{})
+		var obj interface{}
+		var bindingType bindings.BindingType
+		if certificatePath := keyData["certificate_path"].(string); certificatePath != "" {
+			obj = model.LBJwtCertificateKey{
+				Type_:           model.LBJwtKey_TYPE_LBJWTCERTIFICATEKEY,
+				CertificatePath: &certificatePath,
+			}
+			bindingType = model.LBJwtCertificateKeyBindingType()
+		} else if publicKey := keyData["public_key_content"].(string); publicKey != "" {
+			obj = model.LBJwtPublicKey{
+				Type_:            model.LBJwtKey_TYPE_LBJWTPUBLICKEY,
+				PublicKeyContent: &publicKey,
+			}
+			bindingType = model.LBJwtPublicKeyBindingType()
+		} else if keyData["symmetric_key"].(bool) {
+			obj = model.LBJwtSymmetricKey{
+				Type_: model.LBJwtKey_TYPE_LBJWTSYMMETRICKEY,
+			}
+			bindingType = model.LBJwtSymmetricKeyBindingType()
+		} else {
+			return nil, fmt.Errorf("One of certificate_path, public_key_content or symmetric_key needs to be specified for JWT key")
+		}
+
+		dataValue, errs := converter.ConvertToVapi(obj, bindingType)
+		if errs != nil {
+			return nil, errs[0]
+		}
+		return dataValue.(*data.StructValue), nil
+	}
+
+	return nil, nil
+}
+
+func getPolicyLbRuleActionsFromSchema(converter *bindings.TypeConverter, actionMap map[string]interface{}) ([]*data.StructValue, error) {
+	var actions []*data.StructValue
+	var err error
+
+	for _, item := range actionMap["http_redirect"].([]interface{}) {
+		data := item.(map[string]interface{})
+		obj := model.LBHttpRedirectAction{
+			Type_:          model.LBRuleAction_TYPE_LBHTTPREDIRECTACTION,
+			RedirectStatus: getPolicyLbRuleStringAttr(data, "redirect_status"),
+			RedirectUrl:    getPolicyLbRuleStringAttr(data, "redirect_url"),
+		}
+		actions, err = convertPolicyLbRuleElemToVapi(converter, obj, model.LBHttpRedirectActionBindingType(), actions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, item := range actionMap["http_reject"].([]interface{}) {
+		data := item.(map[string]interface{})
+		obj := model.LBHttpRejectAction{
+			Type_:        model.LBRuleAction_TYPE_LBHTTPREJECTACTION,
+			ReplyStatus:  getPolicyLbRuleStringAttr(data, "reply_status"),
+			ReplyMessage: getPolicyLbRuleOptionalStringAttr(data, "reply_message"),
+		}
+		actions, err = convertPolicyLbRuleElemToVapi(converter, obj, model.LBHttpRejectActionBindingType(), actions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, item := range actionMap["http_request_header_delete"].([]interface{}) {
+		data := item.(map[string]interface{})
+		obj := model.LBHttpRequestHeaderDeleteAction{
+			Type_:      model.LBRuleAction_TYPE_LBHTTPREQUESTHEADERDELETEACTION,
+			HeaderName: getPolicyLbRuleStringAttr(data, "header_name"),
+		}
+		actions, err = convertPolicyLbRuleElemToVapi(converter, obj, model.LBHttpRequestHeaderDeleteActionBindingType(), actions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, item := range actionMap["http_request_header_rewrite"].([]interface{}) {
+		data := item.(map[string]interface{})
+		obj := model.LBHttpRequestHeaderRewriteAction{
+			Type_:       model.LBRuleAction_TYPE_LBHTTPREQUESTHEADERREWRITEACTION,
+			HeaderName:  getPolicyLbRuleStringAttr(data, "header_name"),
+			HeaderValue: getPolicyLbRuleStringAttr(data, "header_value"),
+		}
+		actions, err = convertPolicyLbRuleElemToVapi(converter, obj, model.LBHttpRequestHeaderRewriteActionBindingType(), actions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, item := range actionMap["http_response_header_delete"].([]interface{}) {
+		data := item.(map[string]interface{})
+		obj := model.LBHttpResponseHeaderDeleteAction{
+			Type_:      model.LBRuleAction_TYPE_LBHTTPRESPONSEHEADERDELETEACTION,
+			HeaderName: getPolicyLbRuleStringAttr(data, "header_name"),
+		}
+		actions, err = convertPolicyLbRuleElemToVapi(converter, obj, model.LBHttpResponseHeaderDeleteActionBindingType(), actions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, item := range actionMap["http_response_header_rewrite"].([]interface{}) {
+		data := item.(map[string]interface{})
+		obj := model.LBHttpResponseHeaderRewriteAction{
+			Type_:       model.LBRuleAction_TYPE_LBHTTPRESPONSEHEADERREWRITEACTION,
+			HeaderName:  getPolicyLbRuleStringAttr(data, "header_name"),
+			HeaderValue: getPolicyLbRuleStringAttr(data, "header_value"),
+		}
+		actions, err = convertPolicyLbRuleElemToVapi(converter, obj, model.LBHttpResponseHeaderRewriteActionBindingType(), actions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, item := range actionMap["http_request_uri_rewrite"].([]interface{}) {
+		data := item.(map[string]interface{})
+		obj := model.LBHttpRequestUriRewriteAction{
+			Type_:        model.LBRuleAction_TYPE_LBHTTPREQUESTURIREWRITEACTION,
+			Uri:          getPolicyLbRuleStringAttr(data, "uri"),
+			UriArguments: getPolicyLbRuleOptionalStringAttr(data, "uri_arguments"),
+		}
+		actions, err = convertPolicyLbRuleElemToVapi(converter, obj, model.LBHttpRequestUriRewriteActionBindingType(), actions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, item := range actionMap["jwt_auth"].([]interface{}) {
+		data := item.(map[string]interface{})
+		passJwtToPool := data["pass_jwt_to_pool"].(bool)
+		key, err := getPolicyLbRuleJwtKeyFromSchema(converter, data["key"].([]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		obj := model.LBJwtAuthAction{
+			Type_:         model.LBRuleAction_TYPE_LBJWTAUTHACTION,
+			Key:           key,
+			PassJwtToPool: &passJwtToPool,
+			Realm:         getPolicyLbRuleOptionalStringAttr(data, "realm"),
+			Tokens:        interface2StringList(data["tokens"].([]interface{})),
+		}
+		actions, err = convertPolicyLbRuleElemToVapi(converter, obj, model.LBJwtAuthActionBindingType(), actions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, item := range actionMap["select_pool"].([]interface{}) {
+		data := item.(map[string]interface{})
+		obj := model.LBSelectPoolAction{
+			Type_:  model.LBRuleAction_TYPE_LBSELECTPOOLACTION,
+			PoolId: getPolicyLbRuleStringAttr(data, "pool_id"),
+		}
+		actions, err = convertPolicyLbRuleElemToVapi(converter, obj, model.LBSelectPoolActionBindingType(), actions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, item := range actionMap["ssl_mode_selection"].([]interface{}) {
+		data := item.(map[string]interface{})
+		obj := model.LBSslModeSelectionAction{
+			Type_:   model.LBRuleAction_TYPE_LBSSLMODESELECTIONACTION,
+			SslMode: getPolicyLbRuleStringAttr(data, "ssl_mode"),
+		}
+		actions, err = convertPolicyLbRuleElemToVapi(converter, obj, model.LBSslModeSelectionActionBindingType(), actions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, item := range actionMap["variable_assignment"].([]interface{}) {
+		data := item.(map[string]interface{})
+		obj := model.LBVariableAssignmentAction{
+			Type_:         model.LBRuleAction_TYPE_LBVARIABLEASSIGNMENTACTION,
+			VariableName:  getPolicyLbRuleStringAttr(data, "variable_name"),
+			VariableValue: getPolicyLbRuleStringAttr(data, "variable_value"),
+		}
+		actions, err = convertPolicyLbRuleElemToVapi(converter, obj, model.LBVariableAssignmentActionBindingType(), actions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, item := range actionMap["variable_persistence_learn"].([]interface{}) {
+		data := item.(map[string]interface{})
+		hashEnabled := data["variable_hash_enabled"].(bool)
+		obj := model.LBVariablePersistenceLearnAction{
+			Type_:                  model.LBRuleAction_TYPE_LBVARIABLEPERSISTENCELEARNACTION,
+			VariableName:           getPolicyLbRuleStringAttr(data, "variable_name"),
+			PersistenceProfilePath: getPolicyLbRuleOptionalStringAttr(data, "persistence_profile_path"),
+			VariableHashEnabled:    &hashEnabled,
+		}
+		actions, err = convertPolicyLbRuleElemToVapi(converter, obj, model.LBVariablePersistenceLearnActionBindingType(), actions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, item := range actionMap["variable_persistence_on"].([]interface{}) {
+		data := item.(map[string]interface{})
+		hashEnabled := data["variable_hash_enabled"].(bool)
+		obj := model.LBVariablePersistenceOnAction{
+			Type_:                  model.LBRuleAction_TYPE_LBVARIABLEPERSISTENCEONACTION,
+			VariableName:           getPolicyLbRuleStringAttr(data, "variable_name"),
+			PersistenceProfilePath: getPolicyLbRuleOptionalStringAttr(data, "persistence_profile_path"),
+			VariableHashEnabled:    &hashEnabled,
+		}
+		actions, err = convertPolicyLbRuleElemToVapi(converter, obj, model.LBVariablePersistenceOnActionBindingType(), actions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return actions, nil
+}
+
+func getPolicyLbRulesFromSchema(d *schema.ResourceData) ([]model.LBRule, error) {
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+
+	// Empty list (rather than nil) is returned in order to clear rules on NSX
+	rules := []model.LBRule{}
+	for _, rule := range d.Get("rule").([]interface{}) {
+		ruleData := rule.(map[string]interface{})
+		displayName := ruleData["display_name"].(string)
+		matchStrategy := ruleData["match_strategy"].(string)
+		phase := ruleData["phase"].(string)
+
+		obj := model.LBRule{
+			MatchStrategy: &matchStrategy,
+			Phase:         &phase,
+		}
+		if displayName != "" {
+			obj.DisplayName = &displayName
+		}
+
+		for _, condition := range ruleData["condition"].([]interface{}) {
+			// maximum count is one
+			if condition == nil {
+				continue
+			}
+			conditions, err := getPolicyLbRuleConditionsFromSchema(converter, condition.(map[string]interface{}))
+			if err != nil {
+				return nil, err
+			}
+			obj.MatchConditions = conditions
+		}
+
+		for _, action := range ruleData["action"].([]interface{}) {
+			// maximum count is one
+			if action == nil {
+				return nil, fmt.Errorf("At least one action needs to be specified for load balancer rule")
+			}
+			actions, err := getPolicyLbRuleActionsFromSchema(converter, action.(map[string]interface{}))
+			if err != nil {
+				return nil, err
+			}
+			obj.Actions = actions
+		}
+
+		rules = append(rules, obj)
+	}
+
+	return rules, nil
+}
+
+func setPolicyLbRuleMatchAttrsInElem(elem map[string]interface{}, inverse *bool, caseSensitive *bool, matchType *string) {
+	elem["inverse"] = inverse
+	elem["case_sensitive"] = caseSensitive
+	elem["match_type"] = matchType
+}
+
+func appendPolicyLbRuleElem(target map[string]interface{}, key string, elem map[string]interface{}) {
+	list, _ := target[key].([]map[string]interface{})
+	target[key] = append(list, elem)
+}
+
+func getPolicyLbRuleConditionsForSchema(converter *bindings.TypeConverter, conditions []*data.StructValue) (map[string]interface{}, error) {
+	conditionMap := make(map[string]interface{})
+	for _, condition := range conditions {
+		baseObj, errs := converter.ConvertToGolang(condition, model.LBRuleConditionBindingType())
+		if errs != nil {
+			return nil, errs[0]
+		}
+
+		elem := make(map[string]interface{})
+		conditionType := baseObj.(model.LBRuleCondition).Type_
+		switch conditionType {
+		case model.LBRuleCondition_TYPE_LBHTTPREQUESTBODYCONDITION:
+			value, errs := converter.ConvertToGolang(condition, model.LBHttpRequestBodyConditionBindingType())
+			if errs != nil {
+				return nil, errs[0]
+			}
+			obj := value.(model.LBHttpRequestBodyCondition)
+			setPolicyLbRuleMatchAttrsInElem(elem, obj.Inverse, obj.CaseSensitive, obj.MatchType)
+			elem["body_value"] = obj.BodyValue
+			appendPolicyLbRuleElem(conditionMap, "http_request_body", elem)
+		case model.LBRuleCondition_TYPE_LBHTTPREQUESTCOOKIECONDITION:
+			value, errs := converter.ConvertToGolang(condition, model.LBHttpRequestCookieConditionBindingType())
+			if errs != nil {
+				return nil, errs[0]
+			}
+			obj := value.(model.LBHttpRequestCookieCondition)
+			setPolicyLbRuleMatchAttrsInElem(elem, obj.Inverse, obj.CaseSensitive, obj.MatchType)
+			elem["cookie_name"] = obj.CookieName
+			elem["cookie_value"] = obj.CookieValue
+			appendPolicyLbRuleElem(conditionMap, "http_request_cookie", elem)
+		case model.LBRuleCondition_TYPE_LBHTTPREQUESTHEADERCONDITION:
+			value, errs := converter.ConvertToGolang(condition, model.LBHttpRequestHeaderConditionBindingType())
+			if errs != nil {
+				return nil, errs[0]
+			}
+			obj := value.(model.LBHttpRequestHeaderCondition)
+			setPolicyLbRuleMatchAttrsInElem(elem, obj.Inverse, obj.CaseSensitive, obj.MatchType)
+			elem["header_name"] = obj.HeaderName
+			elem["header_value"] = obj.HeaderValue
+			appendPolicyLbRuleElem(conditionMap, "http_request_header", elem)
+		case model.LBRuleCondition_TYPE_LBHTTPREQUESTMETHODCONDITION:
+			value, errs := converter.ConvertToGolang(condition, model.LBHttpRequestMethodConditionBindingType())
+			if errs != nil {
+				return nil, errs[0]
+			}
+			obj := value.(model.LBHttpRequestMethodCondition)
+			elem["inverse"] = obj.Inverse
+			elem["method"] = obj.Method
+			appendPolicyLbRuleElem(conditionMap, "http_request_method", elem)
+		case model.LBRuleCondition_TYPE_LBHTTPREQUESTURIARGUMENTSCONDITION:
+			value, errs := converter.ConvertToGolang(condition, model.LBHttpRequestUriArgumentsConditionBindingType())
+			if errs != nil {
+				return nil, errs[0]
+			}
+			obj := value.(model.LBHttpRequestUriArgumentsCondition)
+			setPolicyLbRuleMatchAttrsInElem(elem, obj.Inverse, obj.CaseSensitive, obj.MatchType)
+			elem["uri_arguments"] = obj.UriArguments
+			appendPolicyLbRuleElem(conditionMap, "http_request_uri_arguments", elem)
+		case model.LBRuleCondition_TYPE_LBHTTPREQUESTURICONDITION:
+			value, errs := converter.ConvertToGolang(condition, model.LBHttpRequestUriConditionBindingType())
+			if errs != nil {
+				return nil, errs[0]
+			}
+			obj := value.(model.LBHttpRequestUriCondition)
+			setPolicyLbRuleMatchAttrsInElem(elem, obj.Inverse, obj.CaseSensitive, obj.MatchType)
+			elem["uri"] = obj.Uri
+			appendPolicyLbRuleElem(conditionMap, "http_request_uri", elem)
+		case model.LBRuleCondition_TYPE_LBHTTPREQUESTVERSIONCONDITION:
+			value, errs := converter.ConvertToGolang(condition, model.LBHttpRequestVersionConditionBindingType())
+			if errs != nil {
+				return nil, errs[0]
+			}
+			obj := value.(model.LBHttpRequestVersionCondition)
+			elem["inverse"] = obj.Inverse
+			elem["version"] = obj.Version
+			appendPolicyLbRuleElem(conditionMap, "http_request_version", elem)
+		case model.LBRuleCondition_TYPE_LBHTTPRESPONSEHEADERCONDITION:
+			value, errs := converter.ConvertToGolang(condition, model.LBHttpResponseHeaderConditionBindingType())
+			if errs != nil {
+				return nil, errs[0]
+			}
+			obj := value.(model.LBHttpResponseHeaderCondition)
+			setPolicyLbRuleMatchAttrsInElem(elem, obj.Inverse, obj.CaseSensitive, obj.MatchType)
+			elem["header_name"] = obj.HeaderName
+			elem["header_value"] = obj.HeaderValue
+			appendPolicyLbRuleElem(conditionMap, "http_response_header", elem)
+		case model.LBRuleCondition_TYPE_LBHTTPSSLCONDITION:
+			value, errs := converter.ConvertToGolang(condition, model.LBHttpSslConditionBindingType())
+			if errs != nil {
+				return nil, errs[0]
+			}
+			obj := value.(model.LBHttpSslCondition)
+			elem["inverse"] = obj.Inverse
+			elem["client_supported_ssl_ciphers"] = obj.ClientSupportedSslCiphers
+			elem["session_reused"] = obj.SessionReused
+			elem["used_protocol"] = obj.UsedProtocol
+			elem["used_ssl_cipher"] = obj.UsedSslCipher
+			if obj.ClientCertificateIssuerDn != nil {
+				dnElem := make(map[string]interface{})
+				dnElem["issuer_dn"] = obj.ClientCertificateIssuerDn.IssuerDn
+				dnElem["case_sensitive"] = obj.ClientCertificateIssuerDn.CaseSensitive
+				dnElem["match_type"] = obj.ClientCertificateIssuerDn.MatchType
+				elem["client_certificate_issuer_dn"] = []map[string]interface{}{dnElem}
+			}
+			if obj.ClientCertificateSubjectDn != nil {
+				dnElem := make(map[string]interface{})
+				dnElem["subject_dn"] = obj.ClientCertificateSubjectDn.SubjectDn
+				dnElem["case_sensitive"] = obj.ClientCertificateSubjectDn.CaseSensitive
+				dnElem["match_type"] = obj.ClientCertificateSubjectDn.MatchType
+				elem["client_certificate_subject_dn"] = []map[string]interface{}{dnElem}
+			}
+			appendPolicyLbRuleElem(conditionMap, "http_ssl", elem)
+		case model.LBRuleCondition_TYPE_LBIPHEADERCONDITION:
+			value, errs := converter.ConvertToGolang(condition, model.LBIpHeaderConditionBindingType())
+			if errs != nil {
+				return nil, errs[0]
+			}
+			obj := value.(model.LBIpHeaderCondition)
+			elem["inverse"] = obj.Inverse
+			elem["source_address"] = obj.SourceAddress
+			elem["group_path"] = obj.GroupPath
+			appendPolicyLbRuleElem(conditionMap, "ip_header", elem)
+		case model.LBRuleCondition_TYPE_LBSSLSNICONDITION:
+			value, errs := converter.ConvertToGolang(condition, model.LBSslSniConditionBindingType())
+			if errs != nil {
+				return nil, errs[0]
+			}
+			obj := value.(model.LBSslSniCondition)
+			setPolicyLbRuleMatchAttrsInElem(elem, obj.Inverse, obj.CaseSensitive, obj.MatchType)
+			elem["sni"] = obj.Sni
+			appendPolicyLbRuleElem(conditionMap, "ssl_sni", elem)
+		case model.LBRuleCondition_TYPE_LBTCPHEADERCONDITION:
+			value, errs := converter.ConvertToGolang(condition, model.LBTcpHeaderConditionBindingType())
+			if errs != nil {
+				return nil, errs[0]
+			}
+			obj := value.(model.LBTcpHeaderCondition)
+			elem["inverse"] = obj.Inverse
+			elem["source_port"] = obj.SourcePort
+			appendPolicyLbRuleElem(conditionMap, "tcp_header", elem)
+		case model.LBRuleCondition_TYPE_LBVARIABLECONDITION:
+			value, errs := converter.ConvertToGolang(condition, model.LBVariableConditionBindingType())
+			if errs != nil {
+				return nil, errs[0]
+			}
+			obj := value.(model.LBVariableCondition)
+			setPolicyLbRuleMatchAttrsInElem(elem, obj.Inverse, obj.CaseSensitive, obj.MatchType)
+			elem["variable_name"] = obj.VariableName
+			elem["variable_value"] = obj.VariableValue
+			appendPolicyLbRuleElem(conditionMap, "variable", elem)
+		default:
+			return nil, fmt.Errorf("Unsupported load balancer rule condition type %s", conditionType)
+		}
+	}
+
+	return conditionMap, nil
+}
+
+func getPolicyLbRuleJwtKeyForSchema(converter *bindings.TypeConverter, key *data.StructValue) ([]map[string]interface{}, error) {
+	if key == nil {
+		return nil, nil
+	}
+
+	baseObj, errs := converter.ConvertToGolang(key, model.LBJwtKeyBindingType())
+	if errs != nil {
+		return nil, errs[0]
+	}
+
+	elem := make(map[string]interface{})
+	keyType := baseObj.(model.LBJwtKey).Type_
+	switch keyType {
+	case model.LBJwtKey_TYPE_LBJWTCERTIFICATEKEY:
+		value, errs := converter.ConvertToGolang(key, model.LBJwtCertificateKeyBindingType())
+		if errs != nil {
+			return nil, errs[0]
+		}
+		elem["certificate_path"] = value.(model.LBJwtCertificateKey).CertificatePath
+	case model.LBJwtKey_TYPE_LBJWTPUBLICKEY:
+		value, errs := converter.ConvertToGolang(key, model.LBJwtPublicKeyBindingType())
+		if errs != nil {
+			return nil, errs[0]
+		}
+		elem["public_key_content"] = value.(model.LBJwtPublicKey).PublicKeyContent
+	case model.LBJwtKey_TYPE_LBJWTSYMMETRICKEY:
+		elem["symmetric_key"] = true
+	default:
+		return nil, fmt.Errorf("Unsupported JWT key type %s", keyType)
+	}
+
+	return []map[string]interface{}{elem}, nil
+}
+
+func getPolicyLbRuleActionsForSchema(converter *bindings.TypeConverter, actions []*data.StructValue) (map[string]interface{}, error) {
+	actionMap := make(map[string]interface{})
+	for _, action := range actions {
+		baseObj, errs := converter.ConvertToGolang(action, model.LBRuleActionBindingType())
+		if errs != nil {
+			return nil, errs[0]
+		}
+
+		elem := make(map[string]interface{})
+		actionType := baseObj.(model.LBRuleAction).Type_
+		switch actionType {
+		case model.LBRuleAction_TYPE_LBHTTPREDIRECTACTION:
+			value, errs := converter.ConvertToGolang(action, model.LBHttpRedirectActionBindingType())
+			if errs != nil {
+				return nil, errs[0]
+			}
+			obj := value.(model.LBHttpRedirectAction)
+			elem["redirect_status"] = obj.RedirectStatus
+			elem["redirect_url"] = obj.RedirectUrl
+			appendPolicyLbRuleElem(actionMap, "http_redirect", elem)
+		case model.LBRuleAction_TYPE_LBHTTPREJECTACTION:
+			value, errs := converter.ConvertToGolang(action, model.LBHttpRejectActionBindingType())
+			if errs != nil {
+				return nil, errs[0]
+			}
+			obj := value.(model.LBHttpRejectAction)
+			elem["reply_status"] = obj.ReplyStatus
+			elem["reply_message"] = obj.ReplyMessage
+			appendPolicyLbRuleElem(actionMap, "http_reject", elem)
+		case model.LBRuleAction_TYPE_LBHTTPREQUESTHEADERDELETEACTION:
+			value, errs := converter.ConvertToGolang(action, model.LBHttpRequestHeaderDeleteActionBindingType())
+			if errs != nil {
+				return nil, errs[0]
+			}
+			elem["header_name"] = value.(model.LBHttpRequestHeaderDeleteAction).HeaderName
+			appendPolicyLbRuleElem(actionMap, "http_request_header_delete", elem)
+		case model.LBRuleAction_TYPE_LBHTTPREQUESTHEADERREWRITEACTION:
+			value, errs := converter.ConvertToGolang(action, model.LBHttpRequestHeaderRewriteActionBindingType())
+			if errs != nil {
+				return nil, errs[0]
+			}
+			obj := value.(model.LBHttpRequestHeaderRewriteAction)
+			elem["header_name"] = obj.HeaderName
+			elem["header_value"] = obj.HeaderValue
+			appendPolicyLbRuleElem(actionMap, "http_request_header_rewrite", elem)
+		case model.LBRuleAction_TYPE_LBHTTPRESPONSEHEADERDELETEACTION:
+			value, errs := converter.ConvertToGolang(action, model.LBHttpResponseHeaderDeleteActionBindingType())
+			if errs != nil {
+				return nil, errs[0]
+			}
+			elem["header_name"] = value.(model.LBHttpResponseHeaderDeleteAction).HeaderName
+			appendPolicyLbRuleElem(actionMap, "http_response_header_delete", elem)
+		case model.LBRuleAction_TYPE_LBHTTPRESPONSEHEADERREWRITEACTION:
+			value, errs := converter.ConvertToGolang(action, model.LBHttpResponseHeaderRewriteActionBindingType())
+			if errs != nil {
+				return nil, errs[0]
+			}
+			obj := value.(model.LBHttpResponseHeaderRewriteAction)
+			elem["header_name"] = obj.HeaderName
+			elem["header_value"] = obj.HeaderValue
+			appendPolicyLbRuleElem(actionMap, "http_response_header_rewrite", elem)
+		case model.LBRuleAction_TYPE_LBHTTPREQUESTURIREWRITEACTION:
+			value, errs := converter.ConvertToGolang(action, model.LBHttpRequestUriRewriteActionBindingType())
+			if errs != nil {
+				return nil, errs[0]
+			}
+			obj := value.(model.LBHttpRequestUriRewriteAction)
+			elem["uri"] = obj.Uri
+			elem["uri_arguments"] = obj.UriArguments
+			appendPolicyLbRuleElem(actionMap, "http_request_uri_rewrite", elem)
+		case model.LBRuleAction_TYPE_LBJWTAUTHACTION:
+			value, errs := converter.ConvertToGolang(action, model.LBJwtAuthActionBindingType())
+			if errs != nil {
+				return nil, errs[0]
+			}
+			obj := value.(model.LBJwtAuthAction)
+			key, err := getPolicyLbRuleJwtKeyForSchema(converter, obj.Key)
+			if err != nil {
+				return nil, err
+			}
+			elem["key"] = key
+			elem["pass_jwt_to_pool"] = obj.PassJwtToPool
+			elem["realm"] = obj.Realm
+			elem["tokens"] = obj.Tokens
+			appendPolicyLbRuleElem(actionMap, "jwt_auth", elem)
+		case model.LBRuleAction_TYPE_LBSELECTPOOLACTION:
+			value, errs := converter.ConvertToGolang(action, model.LBSelectPoolActionBindingType())
+			if errs != nil {
+				return nil, errs[0]
+			}
+			elem["pool_id"] = value.(model.LBSelectPoolAction).PoolId
+			appendPolicyLbRuleElem(actionMap, "select_pool", elem)
+		case model.LBRuleAction_TYPE_LBSSLMODESELECTIONACTION:
+			value, errs := converter.ConvertToGolang(action, model.LBSslModeSelectionActionBindingType())
+			if errs != nil {
+				return nil, errs[0]
+			}
+			elem["ssl_mode"] = value.(model.LBSslModeSelectionAction).SslMode
+			appendPolicyLbRuleElem(actionMap, "ssl_mode_selection", elem)
+		case model.LBRuleAction_TYPE_LBVARIABLEASSIGNMENTACTION:
+			value, errs := converter.ConvertToGolang(action, model.LBVariableAssignmentActionBindingType())
+			if errs != nil {
+				return nil, errs[0]
+			}
+			obj := value.(model.LBVariableAssignmentAction)
+			elem["variable_name"] = obj.VariableName
+			elem["variable_value"] = obj.VariableValue
+			appendPolicyLbRuleElem(actionMap, "variable_assignment", elem)
+		case model.LBRuleAction_TYPE_LBVARIABLEPERSISTENCELEARNACTION:
+			value, errs := converter.ConvertToGolang(action, model.LBVariablePersistenceLearnActionBindingType())
+			if errs != nil {
+				return nil, errs[0]
+			}
+			obj := value.(model.LBVariablePersistenceLearnAction)
+			elem["variable_name"] = obj.VariableName
+			elem["persistence_profile_path"] = obj.PersistenceProfilePath
+			elem["variable_hash_enabled"] = obj.VariableHashEnabled
+			appendPolicyLbRuleElem(actionMap, "variable_persistence_learn", elem)
+		case model.LBRuleAction_TYPE_LBVARIABLEPERSISTENCEONACTION:
+			value, errs := converter.ConvertToGolang(action, model.LBVariablePersistenceOnActionBindingType())
+			if errs != nil {
+				return nil, errs[0]
+			}
+			obj := value.(model.LBVariablePersistenceOnAction)
+			elem["variable_name"] = obj.VariableName
+			elem["persistence_profile_path"] = obj.PersistenceProfilePath
+			elem["variable_hash_enabled"] = obj.VariableHashEnabled
+			appendPolicyLbRuleElem(actionMap, "variable_persistence_on", elem)
+		default:
+			return nil, fmt.Errorf("Unsupported load balancer rule action type %s", actionType)
+		}
+	}
+
+	return actionMap, nil
+}
+
+func setPolicyLbRulesInSchema(d *schema.ResourceData, rules []model.LBRule) error {
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+
+	var ruleList []map[string]interface{}
+	for _, rule := range rules {
+		elem := make(map[string]interface{})
+		elem["display_name"] = rule.DisplayName
+		elem["match_strategy"] = rule.MatchStrategy
+		elem["phase"] = rule.Phase
+
+		if len(rule.MatchConditions) > 0 {
+			conditionMap, err := getPolicyLbRuleConditionsForSchema(converter, rule.MatchConditions)
+			if err != nil {
+				return err
+			}
+			elem["condition"] = []map[string]interface{}{conditionMap}
+		}
+
+		actionMap, err := getPolicyLbRuleActionsForSchema(converter, rule.Actions)
+		if err != nil {
+			return err
+		}
+		elem["action"] = []map[string]interface{}{actionMap}
+
+		ruleList = append(ruleList, elem)
+	}
+
+	return d.Set("rule", ruleList)
+}
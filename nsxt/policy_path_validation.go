@@ -0,0 +1,152 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+)
+
+// Reference to policy objects from resource attribute. Attributes nested
+// in a block are specified as <block>.<attribute>
+type policyPathReference struct {
+	attribute     string
+	resourceTypes []string
+}
+
+// Resolves referenced policy paths via search API during plan, if enabled in
+// provider configuration. References to objects that are created in same plan
+// are not known at plan time, and thus skipped.
+func policyPathsCustomizeDiff(references []policyPathReference) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		clients, ok := m.(nsxtClients)
+		if !ok || !clients.PolicyValidatePaths {
+			return nil
+		}
+
+		var messages []string
+		for _, reference := range references {
+			for _, path := range getPolicyPathReferenceValues(d, reference.attribute) {
+				message, err := validatePolicyPathReference(m, path, reference.resourceTypes)
+				if err != nil {
+					return err
+				}
+				if message != "" {
+					messages = append(messages, fmt.Sprintf("%s: %s", reference.attribute, message))
+				}
+			}
+		}
+
+		if len(messages) > 0 {
+			return fmt.Errorf("Invalid policy path references:\n%s", strings.Join(messages, "\n"))
+		}
+
+		return nil
+	}
+}
+
+func getPolicyPathReferenceValues(d *schema.ResourceDiff, attribute string) []string {
+	var values []interface{}
+	attrs := strings.SplitN(attribute, ".", 2)
+	if !d.HasChange(attrs[0]) || !d.NewValueKnown(attrs[0]) {
+		return nil
+	}
+
+	if len(attrs) == 1 {
+		values = append(values, d.Get(attribute))
+	} else {
+		for _, block := range getPolicyPathReferenceList(d.Get(attrs[0])) {
+			if blockData, ok := block.(map[string]interface{}); ok {
+				values = append(values, blockData[attrs[1]])
+			}
+		}
+	}
+
+	var paths []string
+	seen := make(map[string]bool)
+	for _, value := range values {
+		for _, elem := range getPolicyPathReferenceList(value) {
+			path, ok := elem.(string)
+			// Unknown values and non-path values, such as IP addresses
+			// in rule groups, are skipped
+			if !ok || !isPolicyPath(path) || seen[path] {
+				continue
+			}
+			seen[path] = true
+			paths = append(paths, path)
+		}
+	}
+
+	sort.Strings(paths)
+	return paths
+}
+
+func getPolicyPathReferenceList(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case *schema.Set:
+		return v.List()
+	case []interface{}:
+		return v
+	case nil:
+		return nil
+	}
+	return []interface{}{value}
+}
+
+// Returns description of the problem if the path can not be resolved to
+// object of expected type
+func validatePolicyPathReference(m interface{}, path string, resourceTypes []string) (string, error) {
+	connector := getPolicyConnector(m)
+	query := fmt.Sprintf("%s AND marked_for_delete:false", buildQueryStringFromMap(map[string]string{"path": path}))
+
+	var results []*data.StructValue
+	var err error
+	if isPolicyGlobalManager(m) {
+		results, err = searchGMPolicyResources(connector, query)
+	} else {
+		results, err = searchLMPolicyResources(connector, query)
+	}
+	if err != nil {
+		return "", logAPIError(fmt.Sprintf("Error searching for policy path %s", path), err)
+	}
+
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+	var foundTypes []string
+	for _, result := range results {
+		dataValue, errors := converter.ConvertToGolang(result, model.PolicyResourceBindingType())
+		if len(errors) > 0 {
+			return "", errors[0]
+		}
+		policyResource := dataValue.(model.PolicyResource)
+		if policyResource.Path == nil || *policyResource.Path != path || policyResource.ResourceType == nil {
+			continue
+		}
+
+		if len(resourceTypes) == 0 {
+			return "", nil
+		}
+		for _, resourceType := range resourceTypes {
+			if *policyResource.ResourceType == resourceType {
+				return "", nil
+			}
+		}
+		foundTypes = append(foundTypes, *policyResource.ResourceType)
+	}
+
+	if len(foundTypes) > 0 {
+		return fmt.Sprintf("object %s is of type %s, expected %s", path, strings.Join(foundTypes, ", "), strings.Join(resourceTypes, " or ")), nil
+	}
+
+	log.Printf("[DEBUG] Policy path %s was not found by search", path)
+	return fmt.Sprintf("object %s was not found", path), nil
+}
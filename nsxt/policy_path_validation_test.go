@@ -0,0 +1,163 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// Value terraform uses in raw configuration for attributes not known at plan time
+const testUnknownValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+func testPolicyPathReferenceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"path_string": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"path_list": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"path_set": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"rule": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"groups": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Plans given raw configuration and returns reference values collected for
+// the attribute during diff customization
+func testGetPolicyPathReferenceValues(t *testing.T, attribute string, raw map[string]interface{}) []string {
+	var result []string
+	resource := &schema.Resource{
+		Schema: testPolicyPathReferenceSchema(),
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+			result = getPolicyPathReferenceValues(d, attribute)
+			return nil
+		},
+	}
+
+	_, err := resource.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), nil)
+	if err != nil {
+		t.Fatalf("Unexpected diff error: %v", err)
+	}
+	return result
+}
+
+func TestGetPolicyPathReferenceValues(t *testing.T) {
+	groupPath := "/infra/domains/default/groups/g1"
+	otherGroupPath := "/infra/domains/default/groups/g2"
+	cases := []struct {
+		name      string
+		attribute string
+		raw       map[string]interface{}
+		expected  []string
+	}{
+		{
+			name:      "string",
+			attribute: "path_string",
+			raw:       map[string]interface{}{"path_string": groupPath},
+			expected:  []string{groupPath},
+		},
+		{
+			name:      "not set",
+			attribute: "path_string",
+			raw:       map[string]interface{}{},
+			expected:  nil,
+		},
+		{
+			name:      "unknown string",
+			attribute: "path_string",
+			raw:       map[string]interface{}{"path_string": testUnknownValue},
+			expected:  nil,
+		},
+		{
+			name:      "list",
+			attribute: "path_list",
+			raw:       map[string]interface{}{"path_list": []interface{}{otherGroupPath, groupPath, groupPath}},
+			expected:  []string{groupPath, otherGroupPath},
+		},
+		{
+			name:      "unknown list",
+			attribute: "path_list",
+			raw:       map[string]interface{}{"path_list": testUnknownValue},
+			expected:  nil,
+		},
+		{
+			name:      "set",
+			attribute: "path_set",
+			raw:       map[string]interface{}{"path_set": []interface{}{groupPath, otherGroupPath}},
+			expected:  []string{groupPath, otherGroupPath},
+		},
+		{
+			name:      "set skips non paths",
+			attribute: "path_set",
+			raw:       map[string]interface{}{"path_set": []interface{}{"10.0.0.1", groupPath}},
+			expected:  []string{groupPath},
+		},
+		{
+			name:      "nested set in list",
+			attribute: "rule.groups",
+			raw: map[string]interface{}{"rule": []interface{}{
+				map[string]interface{}{"groups": []interface{}{groupPath}},
+				map[string]interface{}{"groups": []interface{}{otherGroupPath, groupPath}},
+			}},
+			expected: []string{groupPath, otherGroupPath},
+		},
+		{
+			name:      "unknown block",
+			attribute: "rule.groups",
+			raw:       map[string]interface{}{"rule": testUnknownValue},
+			expected:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		result := testGetPolicyPathReferenceValues(t, tc.attribute, tc.raw)
+		if !reflect.DeepEqual(result, tc.expected) {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.expected, result)
+		}
+	}
+}
+
+func TestPolicyPathsCustomizeDiffDisabled(t *testing.T) {
+	references := []policyPathReference{
+		{attribute: "path_string", resourceTypes: []string{"Group"}},
+	}
+	// Validation would need a policy connector, thus any attempt to resolve
+	// the path fails the test
+	resource := &schema.Resource{
+		Schema:        testPolicyPathReferenceSchema(),
+		CustomizeDiff: policyPathsCustomizeDiff(references),
+	}
+	raw := map[string]interface{}{"path_string": "/infra/domains/default/groups/g1"}
+
+	for _, meta := range []interface{}{nil, nsxtClients{PolicyValidatePaths: false}} {
+		_, err := resource.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), meta)
+		if err != nil {
+			t.Errorf("Expected no validation with provider meta %v, got error: %v", meta, err)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"math/rand"
+	"net/http"
+	"time"
+
+	api "github.com/vmware/go-vmware-nsxt"
+)
+
+// Retries policy API calls based on same provider settings as MP client,
+// with exponential backoff and jitter. Optionally, updates that failed
+// due to stale revision are repeated with current revision of the object.
+type policyRetryTransport struct {
+	transport     http.RoundTripper
+	config        api.ClientRetriesConfiguration
+	revisionRetry bool
+}
+
+func newPolicyRetryTransport(transport http.RoundTripper, config api.ClientRetriesConfiguration, revisionRetry bool) *policyRetryTransport {
+	return &policyRetryTransport{
+		transport:     transport,
+		config:        config,
+		revisionRetry: revisionRetry,
+	}
+}
+
+// Actions, such as publish or traceflow, are not idempotent and are not
+// retried. Same goes for POST, which is used to create objects and invoke
+// actions.
+func isPolicyRequestRetryable(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodPut, http.MethodPatch, http.MethodDelete:
+	default:
+		return false
+	}
+	_, isAction := req.URL.Query()["action"]
+	return !isAction
+}
+
+func (t *policyRetryTransport) shouldRetry(req *http.Request, statusCode int) bool {
+	if !isPolicyRequestRetryable(req) {
+		return false
+	}
+	for _, status := range t.config.RetryOnStatuses {
+		if status == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+// Delay grows exponentially with each attempt up to max delay, with random
+// jitter of up to half of the delay
+func (t *policyRetryTransport) getDelay(attempt int) time.Duration {
+	delay := t.config.RetryMinDelay
+	for i := 0; i < attempt && delay < t.config.RetryMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > t.config.RetryMaxDelay {
+		delay = t.config.RetryMaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+
+	jitter := rand.Intn(delay/2 + 1)
+	return time.Duration(delay-jitter) * time.Millisecond
+}
+
+func isPolicyRevisionConflict(req *http.Request, resp *http.Response) bool {
+	if req.Method != http.MethodPut {
+		return false
+	}
+	return resp.StatusCode == http.StatusConflict || resp.StatusCode == http.StatusPreconditionFailed
+}
+
+func copyPolicyRequest(req *http.Request) (*http.Request, error) {
+	retryReq := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		retryReq.Body = body
+	}
+	return retryReq, nil
+}
+
+func decodePolicyJSONObject(data []byte) (map[string]interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	obj := make(map[string]interface{})
+	err := decoder.Decode(&obj)
+	return obj, err
+}
+
+// Returns copy of update request with revision of the object as currently
+// stored on NSX, or nil if the request does not specify revision
+func (t *policyRetryTransport) getRevisionRetryRequest(req *http.Request) (*http.Request, error) {
+	if req.GetBody == nil {
+		return nil, nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	bodyData, err := ioutil.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := decodePolicyJSONObject(bodyData)
+	if err != nil {
+		return nil, nil
+	}
+	if _, ok := obj["_revision"]; !ok {
+		return nil, nil
+	}
+
+	getReq := req.Clone(req.Context())
+	getReq.Method = http.MethodGet
+	getReq.Body = nil
+	getReq.GetBody = nil
+	getReq.ContentLength = 0
+	getReq.Header.Del("Content-Type")
+	resp, err := t.transport.RoundTrip(getReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Unexpected status %d while retrieving current revision of %s", resp.StatusCode, req.URL.Path)
+	}
+	currentData, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	current, err := decodePolicyJSONObject(currentData)
+	if err != nil {
+		return nil, err
+	}
+	revision, ok := current["_revision"]
+	if !ok {
+		return nil, fmt.Errorf("Current revision of %s is unknown", req.URL.Path)
+	}
+
+	log.Printf("[INFO] Revision of %s is stale, repeating update with revision %v", req.URL.Path, revision)
+	obj["_revision"] = revision
+	bodyData, err = json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	retryReq := req.Clone(req.Context())
+	retryReq.Body = ioutil.NopCloser(bytes.NewReader(bodyData))
+	retryReq.ContentLength = int64(len(bodyData))
+	retryReq.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(bodyData)), nil
+	}
+	return retryReq, nil
+}
+
+func (t *policyRetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Base for retries, updated once revision is refreshed
+	baseReq := req
+	resp, err := t.transport.RoundTrip(req)
+	for attempt := 0; attempt < t.config.MaxRetries; attempt++ {
+		if err != nil {
+			return resp, err
+		}
+
+		if req.Body != nil && req.GetBody == nil {
+			// Request body can not be replayed
+			return resp, nil
+		}
+
+		var retryReq *http.Request
+		if t.revisionRetry && isPolicyRevisionConflict(baseReq, resp) {
+			retryReq, err = t.getRevisionRetryRequest(baseReq)
+			if err != nil {
+				log.Printf("[ERROR] Failed to refresh revision for %s: %v", req.URL.Path, err)
+				return resp, nil
+			}
+			if retryReq != nil {
+				baseReq = retryReq
+				retryReq, err = copyPolicyRequest(baseReq)
+				if err != nil {
+					return resp, nil
+				}
+			}
+		}
+
+		if retryReq == nil {
+			if !t.shouldRetry(baseReq, resp.StatusCode) {
+				return resp, nil
+			}
+
+			retryReq, err = copyPolicyRequest(baseReq)
+			if err != nil {
+				return resp, nil
+			}
+
+			delay := t.getDelay(attempt)
+			log.Printf("[DEBUG] Retrying %s %s after status %d in %v (attempt %d of %d)", req.Method, req.URL.Path, resp.StatusCode, delay, attempt+1, t.config.MaxRetries)
+			timer := time.NewTimer(delay)
+			select {
+			case <-req.Context().Done():
+				timer.Stop()
+				resp.Body.Close()
+				return nil, req.Context().Err()
+			case <-timer.C:
+			}
+		}
+
+		resp.Body.Close()
+		resp, err = t.transport.RoundTrip(retryReq)
+	}
+
+	return resp, err
+}
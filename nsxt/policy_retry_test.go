@@ -0,0 +1,294 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	api "github.com/vmware/go-vmware-nsxt"
+)
+
+// Records requests received by test server
+type testRequestLog struct {
+	mutex    sync.Mutex
+	requests []string
+	bodies   []string
+}
+
+func (l *testRequestLog) add(r *http.Request) string {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	body, _ := ioutil.ReadAll(r.Body)
+	l.requests = append(l.requests, r.Method)
+	l.bodies = append(l.bodies, string(body))
+	return string(body)
+}
+
+func (l *testRequestLog) get() ([]string, []string) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return append([]string{}, l.requests...), append([]string{}, l.bodies...)
+}
+
+func testPolicyRetryConfig(maxRetries int, minDelay int, maxDelay int) api.ClientRetriesConfiguration {
+	return api.ClientRetriesConfiguration{
+		MaxRetries:      maxRetries,
+		RetryMinDelay:   minDelay,
+		RetryMaxDelay:   maxDelay,
+		RetryOnStatuses: []int{http.StatusServiceUnavailable, http.StatusTooManyRequests},
+	}
+}
+
+// Server that responds with given statuses in order, repeating the last one
+func newTestStatusSequenceServer(requestLog *testRequestLog, statuses ...int) *httptest.Server {
+	call := 0
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestLog.add(r)
+		status := statuses[len(statuses)-1]
+		if call < len(statuses) {
+			status = statuses[call]
+		}
+		call++
+		w.WriteHeader(status)
+	}))
+}
+
+func testPolicyRetryRoundTrip(t *testing.T, transport http.RoundTripper, req *http.Request) int {
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	return resp.StatusCode
+}
+
+func TestPolicyRetryTransportRetryCount(t *testing.T) {
+	cases := []struct {
+		name           string
+		statuses       []int
+		expectedStatus int
+		expectedCalls  int
+	}{
+		{"success", []int{http.StatusOK}, http.StatusOK, 1},
+		{"not retryable", []int{http.StatusBadRequest}, http.StatusBadRequest, 1},
+		{"recovered", []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK}, http.StatusOK, 3},
+		{"retries exhausted", []int{http.StatusServiceUnavailable}, http.StatusServiceUnavailable, 4},
+	}
+
+	for _, tc := range cases {
+		requestLog := &testRequestLog{}
+		server := newTestStatusSequenceServer(requestLog, tc.statuses...)
+		transport := newPolicyRetryTransport(server.Client().Transport, testPolicyRetryConfig(3, 1, 2), false)
+
+		req, _ := http.NewRequest("PATCH", server.URL, strings.NewReader(`{"display_name": "test"}`))
+		status := testPolicyRetryRoundTrip(t, transport, req)
+		server.Close()
+
+		_, bodies := requestLog.get()
+		if status != tc.expectedStatus {
+			t.Errorf("%s: expected status %d, got %d", tc.name, tc.expectedStatus, status)
+		}
+		if len(bodies) != tc.expectedCalls {
+			t.Errorf("%s: expected %d calls, got %d", tc.name, tc.expectedCalls, len(bodies))
+		}
+		for _, body := range bodies {
+			if body != `{"display_name": "test"}` {
+				t.Errorf("%s: expected request body to be replayed, got %q", tc.name, body)
+			}
+		}
+	}
+}
+
+func TestPolicyRetryTransportRequestNotRetryable(t *testing.T) {
+	cases := []struct {
+		method        string
+		path          string
+		expectedCalls int
+	}{
+		{"GET", "/policy/api/v1/infra/domains/default/groups/test", 4},
+		{"DELETE", "/policy/api/v1/infra/domains/default/groups/test", 4},
+		{"PUT", "/policy/api/v1/infra/domains/default/groups/test", 4},
+		{"POST", "/policy/api/v1/infra/sites/site1/onboarding", 1},
+		{"POST", "/policy/api/v1/infra/drafts/test?action=publish", 1},
+		{"PATCH", "/policy/api/v1/infra/domains/default/groups/test?action=revise", 1},
+		{"PUT", "/policy/api/v1/infra/traceflows/test?action=", 1},
+	}
+
+	for _, tc := range cases {
+		requestLog := &testRequestLog{}
+		server := newTestStatusSequenceServer(requestLog, http.StatusServiceUnavailable)
+		transport := newPolicyRetryTransport(server.Client().Transport, testPolicyRetryConfig(3, 1, 2), true)
+
+		req, _ := http.NewRequest(tc.method, server.URL+tc.path, strings.NewReader("{}"))
+		status := testPolicyRetryRoundTrip(t, transport, req)
+		server.Close()
+
+		requests, _ := requestLog.get()
+		if status != http.StatusServiceUnavailable || len(requests) != tc.expectedCalls {
+			t.Errorf("%s %s: expected %d calls, got status %d after %d calls", tc.method, tc.path, tc.expectedCalls, status, len(requests))
+		}
+	}
+}
+
+func TestPolicyRetryTransportNonReplayableBody(t *testing.T) {
+	requestLog := &testRequestLog{}
+	server := newTestStatusSequenceServer(requestLog, http.StatusServiceUnavailable)
+	defer server.Close()
+	transport := newPolicyRetryTransport(server.Client().Transport, testPolicyRetryConfig(3, 1, 2), false)
+
+	req, _ := http.NewRequest("PATCH", server.URL, ioutil.NopCloser(strings.NewReader("{}")))
+	status := testPolicyRetryRoundTrip(t, transport, req)
+	requests, _ := requestLog.get()
+	if status != http.StatusServiceUnavailable || len(requests) != 1 {
+		t.Errorf("Expected no retry, got status %d after %d calls", status, len(requests))
+	}
+}
+
+// Server that stores object with given revision, and rejects updates with
+// stale revision
+func newTestRevisionServer(requestLog *testRequestLog, revision int, conflictStatus int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := requestLog.add(r)
+		if r.Method == http.MethodGet {
+			fmt.Fprintf(w, `{"id": "test", "display_name": "current", "_revision": %d}`, revision)
+			return
+		}
+		obj, err := decodePolicyJSONObject([]byte(body))
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if requestRevision, ok := obj["_revision"]; ok && fmt.Sprintf("%v", requestRevision) != fmt.Sprintf("%d", revision) {
+			w.WriteHeader(conflictStatus)
+			return
+		}
+		fmt.Fprint(w, body)
+	}))
+}
+
+func TestPolicyRetryTransportRevisionConflict(t *testing.T) {
+	for _, conflictStatus := range []int{http.StatusConflict, http.StatusPreconditionFailed} {
+		requestLog := &testRequestLog{}
+		server := newTestRevisionServer(requestLog, 5, conflictStatus)
+		transport := newPolicyRetryTransport(server.Client().Transport, testPolicyRetryConfig(3, 1, 2), true)
+
+		req, _ := http.NewRequest("PUT", server.URL+"/policy/api/v1/infra/domains/default/groups/test", strings.NewReader(`{"display_name": "updated", "_revision": 3}`))
+		status := testPolicyRetryRoundTrip(t, transport, req)
+		server.Close()
+
+		requests, bodies := requestLog.get()
+		if status != http.StatusOK {
+			t.Errorf("status %d: expected update with fresh revision to succeed, got %d", conflictStatus, status)
+		}
+		if strings.Join(requests, ",") != "PUT,GET,PUT" {
+			t.Errorf("status %d: expected PUT,GET,PUT, got %v", conflictStatus, requests)
+		}
+		if len(bodies) != 3 {
+			continue
+		}
+		obj, err := decodePolicyJSONObject([]byte(bodies[2]))
+		if err != nil {
+			t.Fatalf("status %d: invalid repeated update body %s", conflictStatus, bodies[2])
+		}
+		if fmt.Sprintf("%v", obj["_revision"]) != "5" || obj["display_name"] != "updated" {
+			t.Errorf("status %d: expected update with revision 5 and original attributes, got %s", conflictStatus, bodies[2])
+		}
+	}
+}
+
+func TestPolicyRetryTransportRevisionConflictNotRetried(t *testing.T) {
+	cases := []struct {
+		name          string
+		method        string
+		body          string
+		revisionRetry bool
+	}{
+		{"disabled", "PUT", `{"_revision": 3}`, false},
+		{"no revision", "PUT", `{"display_name": "updated"}`, true},
+		{"patch", "PATCH", `{"_revision": 3}`, true},
+		{"not json", "PUT", `_revision`, true},
+	}
+
+	for _, tc := range cases {
+		requestLog := &testRequestLog{}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestLog.add(r)
+			w.WriteHeader(http.StatusPreconditionFailed)
+		}))
+		transport := newPolicyRetryTransport(server.Client().Transport, testPolicyRetryConfig(3, 1, 2), tc.revisionRetry)
+
+		req, _ := http.NewRequest(tc.method, server.URL, strings.NewReader(tc.body))
+		status := testPolicyRetryRoundTrip(t, transport, req)
+		server.Close()
+
+		requests, _ := requestLog.get()
+		if status != http.StatusPreconditionFailed || len(requests) != 1 {
+			t.Errorf("%s: expected conflict to be returned as is, got status %d after %v", tc.name, status, requests)
+		}
+	}
+}
+
+func TestPolicyRetryTransportCancelDuringBackoff(t *testing.T) {
+	requestLog := &testRequestLog{}
+	server := newTestStatusSequenceServer(requestLog, http.StatusServiceUnavailable)
+	defer server.Close()
+	transport := newPolicyRetryTransport(server.Client().Transport, testPolicyRetryConfig(3, 60000, 60000), false)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		cancel()
+	}()
+
+	req, _ := http.NewRequestWithContext(ctx, "GET", server.URL, nil)
+	start := time.Now()
+	_, err := transport.RoundTrip(req)
+	if err != context.Canceled {
+		t.Errorf("Expected cancellation error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Expected backoff to be interrupted, took %v", elapsed)
+	}
+	requests, _ := requestLog.get()
+	if len(requests) != 1 {
+		t.Errorf("Expected single call, got %d", len(requests))
+	}
+}
+
+func TestPolicyRetryTransportGetDelay(t *testing.T) {
+	cases := []struct {
+		minDelay int
+		maxDelay int
+		attempt  int
+		lower    time.Duration
+		upper    time.Duration
+	}{
+		{100, 1000, 0, 50 * time.Millisecond, 100 * time.Millisecond},
+		{100, 1000, 1, 100 * time.Millisecond, 200 * time.Millisecond},
+		{100, 1000, 3, 400 * time.Millisecond, 800 * time.Millisecond},
+		{100, 1000, 4, 500 * time.Millisecond, 1000 * time.Millisecond},
+		{100, 1000, 100, 500 * time.Millisecond, 1000 * time.Millisecond},
+		{500, 200, 0, 100 * time.Millisecond, 200 * time.Millisecond},
+		{0, 1000, 5, 0, 0},
+	}
+
+	for _, tc := range cases {
+		transport := newPolicyRetryTransport(nil, testPolicyRetryConfig(3, tc.minDelay, tc.maxDelay), false)
+		for i := 0; i < 50; i++ {
+			delay := transport.getDelay(tc.attempt)
+			if delay < tc.lower || delay > tc.upper {
+				t.Errorf("min %d max %d attempt %d: expected delay between %v and %v, got %v", tc.minDelay, tc.maxDelay, tc.attempt, tc.lower, tc.upper, delay)
+				break
+			}
+		}
+	}
+}
@@ -5,9 +5,12 @@ package nsxt
 
 import (
 	"fmt"
+	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -173,3 +176,173 @@ func searchLMPolicyResources(connector *client.RestConnector, query string) ([]*
 		}
 	}
 }
+
+func getPolicyDataSourceListSchema(extraSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	result := map[string]*schema.Schema{
+		"id": getDataSourceIDSchema(),
+		"display_name_regex": {
+			Type:         schema.TypeString,
+			Description:  "Regular expression to filter objects by display name",
+			Optional:     true,
+			ValidateFunc: validation.StringIsValidRegExp,
+		},
+		"parent_path": getPolicyPathSchema(false, false, "Policy path of the parent of the objects"),
+		"tag_scope":   getPolicyObjectTagScopeSchema(),
+		"tag_value":   getPolicyObjectTagValueSchema(),
+		"paths": {
+			Type:        schema.TypeMap,
+			Description: "Mapping of display name to policy path",
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"ids": {
+			Type:        schema.TypeMap,
+			Description: "Mapping of display name to ID",
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"items": {
+			Type:        schema.TypeList,
+			Description: "Matching objects",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Description: "ID of the object",
+						Computed:    true,
+					},
+					"display_name": {
+						Type:        schema.TypeString,
+						Description: "Display name of the object",
+						Computed:    true,
+					},
+					"path": {
+						Type:        schema.TypeString,
+						Description: "Policy path of the object",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+
+	for key, value := range extraSchema {
+		result[key] = value
+	}
+	return result
+}
+
+// Display names are not required to be unique. Objects that share display
+// name are keyed by display name followed by their unique key, so that none
+// of them is dropped from a mapping by display name
+func getUniqueDisplayNameKeys(namesByKey map[string]string) map[string]string {
+	nameCount := make(map[string]int)
+	for _, name := range namesByKey {
+		nameCount[name]++
+	}
+
+	result := make(map[string]string)
+	for key, name := range namesByKey {
+		if nameCount[name] > 1 {
+			result[key] = fmt.Sprintf("%s (%s)", name, key)
+		} else {
+			result[key] = name
+		}
+	}
+	return result
+}
+
+// Lists all objects of given type that match filters in schema, and sets
+// mappings of display name to path and ID, as well as list of objects
+// sorted by path
+func policyDataSourceResourceListRead(d *schema.ResourceData, connector *client.RestConnector, isGlobalManager bool, resourceType string, additionalQuery map[string]string) error {
+	query := make(map[string]string)
+	for key, value := range additionalQuery {
+		query[key] = value
+	}
+	if parentPath := d.Get("parent_path").(string); parentPath != "" {
+		query["parent_path"] = parentPath
+	}
+	if tagScope := d.Get("tag_scope").(string); tagScope != "" {
+		query["tags.scope"] = tagScope
+	}
+	if tagValue := d.Get("tag_value").(string); tagValue != "" {
+		query["tags.tag"] = tagValue
+	}
+
+	var nameRegex *regexp.Regexp
+	if regex := d.Get("display_name_regex").(string); regex != "" {
+		nameRegex = regexp.MustCompile(regex)
+	}
+
+	queryString := buildQueryStringFromMap(query)
+	resultValues, err := listPolicyResourcesByType(connector, isGlobalManager, &resourceType, &queryString)
+	if err != nil {
+		return handleListError(resourceType, err)
+	}
+
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+	var paths []string
+	itemsByPath := make(map[string]map[string]interface{})
+	for _, result := range resultValues {
+		dataValue, errors := converter.ConvertToGolang(result, model.PolicyResourceBindingType())
+		if len(errors) > 0 {
+			return errors[0]
+		}
+		policyResource := dataValue.(model.PolicyResource)
+		if policyResource.ResourceType == nil || resourceType != *policyResource.ResourceType {
+			continue
+		}
+		if policyResource.Id == nil || policyResource.Path == nil {
+			continue
+		}
+		name := ""
+		if policyResource.DisplayName != nil {
+			name = *policyResource.DisplayName
+		}
+		if nameRegex != nil && !nameRegex.MatchString(name) {
+			continue
+		}
+
+		path := *policyResource.Path
+		if _, ok := itemsByPath[path]; ok {
+			continue
+		}
+		paths = append(paths, path)
+		itemsByPath[path] = map[string]interface{}{
+			"id":           *policyResource.Id,
+			"display_name": name,
+			"path":         path,
+		}
+	}
+
+	namesByPath := make(map[string]string)
+	for path, item := range itemsByPath {
+		namesByPath[path] = item["display_name"].(string)
+	}
+	keys := getUniqueDisplayNameKeys(namesByPath)
+
+	sort.Strings(paths)
+	pathMap := make(map[string]string)
+	idMap := make(map[string]string)
+	var items []interface{}
+	for _, path := range paths {
+		item := itemsByPath[path]
+		pathMap[keys[path]] = path
+		idMap[keys[path]] = item["id"].(string)
+		items = append(items, item)
+	}
+
+	d.Set("paths", pathMap)
+	d.Set("ids", idMap)
+	d.Set("items", items)
+	d.SetId(newUUID())
+
+	return nil
+}
@@ -0,0 +1,29 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGetUniqueDisplayNameKeys(t *testing.T) {
+	namesByPath := map[string]string{
+		"/infra/domains/default/groups/web1": "web",
+		"/infra/domains/default/groups/web2": "web",
+		"/infra/domains/default/groups/db":   "db",
+		"/infra/domains/default/groups/none": "",
+	}
+	expected := map[string]string{
+		"/infra/domains/default/groups/web1": "web (/infra/domains/default/groups/web1)",
+		"/infra/domains/default/groups/web2": "web (/infra/domains/default/groups/web2)",
+		"/infra/domains/default/groups/db":   "db",
+		"/infra/domains/default/groups/none": "",
+	}
+
+	result := getUniqueDisplayNameKeys(namesByPath)
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
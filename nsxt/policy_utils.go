@@ -5,7 +5,6 @@ package nsxt
 
 import (
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
@@ -97,7 +96,7 @@ func setCustomizedPolicyTagsInSchema(d *schema.ResourceData, tags []model.Tag, s
 	}
 	err := d.Set(schemaName, tagList)
 	if err != nil {
-		log.Printf("[WARNING] Failed to set tag in schema: %v", err)
+		addResourceWarning(d, "Failed to set tag in schema", err.Error())
 	}
 }
 
@@ -183,10 +182,6 @@ func interfaceListToStringList(interfaces []interface{}) []string {
 	return strList
 }
 
-func policyResourceNotSupportedError() error {
-	return fmt.Errorf("This NSX policy resource is not supported with given provider settings")
-}
-
 func collectSeparatedStringListToMap(stringList []string, separator string) map[string]string {
 	strMap := make(map[string]string)
 	for _, elem := range stringList {
@@ -4,6 +4,7 @@
 package nsxt
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
@@ -13,7 +14,9 @@ import (
 	"net/http"
 	"regexp"
 	"strings"
+	"sync"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	api "github.com/vmware/go-vmware-nsxt"
@@ -25,11 +28,45 @@ import (
 
 var defaultRetryOnStatusCodes = []int{429, 503}
 
+// Cleanup of configured provider instances, such as NSX sessions
+var shutdownHooks []func()
+var shutdownHooksMutex sync.Mutex
+
+func registerShutdownHook(hook func()) {
+	shutdownHooksMutex.Lock()
+	defer shutdownHooksMutex.Unlock()
+
+	shutdownHooks = append(shutdownHooks, hook)
+}
+
+// Shutdown releases resources held by configured provider instances.
+// It should be called once plugin is done serving requests.
+func Shutdown() {
+	shutdownHooksMutex.Lock()
+	defer shutdownHooksMutex.Unlock()
+
+	for _, hook := range shutdownHooks {
+		hook()
+	}
+	shutdownHooks = nil
+}
+
 // Provider configuration that is shared for policy and MP
 type commonProviderConfig struct {
 	RemoteAuth             bool
-	BearerToken            string
 	ToleratePartialSuccess bool
+	// Set when VMC token authentication is used
+	VmcAuthProcessor *vmcAuthHeaderProcessor
+	// Set when session authentication is used
+	Session *nsxtSession
+	// Client side limits of API calls
+	RateLimiter *apiRateLimiter
+	// Set when failover hosts are configured
+	HostFailover *nsxtHostFailover
+	// Set when API debug logging or HAR recording is enabled
+	APILogger *apiLogger
+	// Proxy, TLS, timeout and header settings of all connections
+	HTTPConfig *providerHTTPConfig
 }
 
 type nsxtClients struct {
@@ -37,22 +74,28 @@ type nsxtClients struct {
 	// NSX Manager client - based on go-vmware-nsxt SDK
 	NsxtClient *api.APIClient
 	// Data for NSX Policy client - based on vsphere-automation-sdk-go SDK
-	// First offering of Policy SDK does not support concurrent
-	// operations in single connector. In order to avoid heavy locks,
-	// we are allocating connector per provider operation.
-	// TODO: when concurrency support is introduced policy client,
-	// change this code to allocate single connector for all provider
-	// operations.
+	// Policy SDK connector stores per-call metadata, and thus does not
+	// support concurrent operations. Connector is allocated per provider
+	// operation, however all connectors share single HTTP client, and
+	// thus connection pool and API rate limits.
 	PolicySecurityContext  *core.SecurityContextImpl
 	PolicyHTTPClient       *http.Client
 	Host                   string
 	PolicyEnforcementPoint string
 	PolicyGlobalManager    bool
+	// Set when firewall auto draft is enabled
+	PolicyFirewallAutoDraft *policyFirewallAutoDraft
+	PolicyValidatePaths     bool
+	// NSX version is detected per provider instance
+	NsxVersion string
+	// Context of current provider operation, cancelled when operation is
+	// interrupted. Defaults to provider stop context.
+	Context context.Context
 }
 
 // Provider for VMWare NSX-T
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 
 		Schema: map[string]*schema.Schema{
 			"allow_unverified_ssl": {
@@ -71,11 +114,23 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("NSXT_PASSWORD", nil),
 				Sensitive:   true,
 			},
+			"retry_on_revision_conflict": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Repeat policy update with current object revision if update fails due to stale revision",
+				DefaultFunc: schema.EnvDefaultFunc("NSXT_RETRY_ON_REVISION_CONFLICT", false),
+			},
 			"remote_auth": {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("NSXT_REMOTE_AUTH", false),
 			},
+			"session_auth": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Authenticate once per provider run and reuse NSX session, rather than sending credentials with each request",
+				DefaultFunc: schema.EnvDefaultFunc("NSXT_SESSION_AUTH", false),
+			},
 			"host": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -83,6 +138,15 @@ func Provider() *schema.Provider {
 				ValidateFunc: validateNsxtProviderHostFormat(),
 				Description:  "The hostname or IP address of the NSX manager.",
 			},
+			"failover_hosts": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Additional NSX manager hosts to fail over to, such as cluster nodes when host is cluster VIP",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateNsxtProviderHostFormat(),
+				},
+			},
 			"client_auth_cert_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -162,6 +226,86 @@ func Provider() *schema.Provider {
 				Description: "Is this a policy global manager endpoint",
 				DefaultFunc: schema.EnvDefaultFunc("NSXT_GLOBAL_MANAGER", false),
 			},
+			"firewall_auto_draft": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Capture firewall configuration in a draft before security policy changes are applied",
+				DefaultFunc: schema.EnvDefaultFunc("NSXT_FIREWALL_AUTO_DRAFT", false),
+			},
+			"validate_policy_paths": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Verify during plan that policy paths referenced by resources exist",
+				DefaultFunc: schema.EnvDefaultFunc("NSXT_VALIDATE_POLICY_PATHS", false),
+			},
+			"api_rate_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Maximum number of NSX API calls per second. Zero means no limit",
+				DefaultFunc:  schema.EnvDefaultFunc("NSXT_API_RATE_LIMIT", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"api_max_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Maximum number of NSX API calls in flight. Zero means no limit",
+				DefaultFunc:  schema.EnvDefaultFunc("NSXT_API_MAX_CONCURRENCY", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"api_debug_logging": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Log NSX API calls, with secrets redacted. Bodies are logged with TRACE level",
+				DefaultFunc: schema.EnvDefaultFunc("NSXT_API_DEBUG_LOGGING", false),
+			},
+			"api_har_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path of HAR file to record NSX API calls in, with secrets redacted",
+				DefaultFunc: schema.EnvDefaultFunc("NSXT_API_HAR_FILE", nil),
+			},
+			"proxy_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "URL of proxy for all connections of the provider. Overrides proxy settings from environment",
+				DefaultFunc:  schema.EnvDefaultFunc("NSXT_PROXY_URL", nil),
+				ValidateFunc: validation.IsURLWithScheme([]string{"http", "https", "socks5"}),
+			},
+			"no_proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comma-separated list of hosts, domains and networks that should be reached without proxy. Overrides NO_PROXY environment variable",
+				DefaultFunc: schema.EnvDefaultFunc("NSXT_NO_PROXY", nil),
+			},
+			"tls_min_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Minimum TLS version for all connections of the provider",
+				DefaultFunc:  schema.EnvDefaultFunc("NSXT_TLS_MIN_VERSION", nil),
+				ValidateFunc: validation.StringInSlice([]string{"1.0", "1.1", "1.2", "1.3"}, false),
+			},
+			"connect_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Timeout in seconds for establishing connection, including TLS handshake",
+				DefaultFunc:  schema.EnvDefaultFunc("NSXT_CONNECT_TIMEOUT", 30),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"idle_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Time in seconds before idle connection is closed. Zero means no limit",
+				DefaultFunc:  schema.EnvDefaultFunc("NSXT_IDLE_TIMEOUT", 90),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"custom_headers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Custom HTTP headers to add to every request",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"license_keys": {
 				Type:          schema.TypeList,
 				Optional:      true,
@@ -243,6 +387,21 @@ func Provider() *schema.Provider {
 			"nsxt_policy_dhcp_server":               dataSourceNsxtPolicyDhcpServer(),
 			"nsxt_policy_bfd_profile":               dataSourceNsxtPolicyBfdProfile(),
 			"nsxt_policy_intrusion_service_profile": dataSourceNsxtPolicyIntrusionServiceProfile(),
+			"nsxt_policy_traceflow":                 dataSourceNsxtPolicyTraceflow(),
+			"nsxt_policy_segment_arp_table":         dataSourceNsxtPolicySegmentArpTable(),
+			"nsxt_policy_segment_mac_table":         dataSourceNsxtPolicySegmentMacTable(),
+			"nsxt_policy_segment_tep_table":         dataSourceNsxtPolicySegmentTepTable(),
+			"nsxt_policy_segment_statistics":        dataSourceNsxtPolicySegmentStatistics(),
+			"nsxt_policy_lb_service_usage":          dataSourceNsxtPolicyLBServiceUsage(),
+			"nsxt_policy_lb_node_usage":             dataSourceNsxtPolicyLBNodeUsage(),
+			"nsxt_policy_lb_pool_status":            dataSourceNsxtPolicyLBPoolStatus(),
+			"nsxt_policy_span":                      dataSourceNsxtPolicySpan(),
+			"nsxt_policy_object":                    dataSourceNsxtPolicyObject(),
+			"nsxt_policy_objects":                   dataSourceNsxtPolicyObjects(),
+			"nsxt_policy_groups":                    dataSourceNsxtPolicyGroups(),
+			"nsxt_policy_segments":                  dataSourceNsxtPolicySegments(),
+			"nsxt_policy_tier1_gateways":            dataSourceNsxtPolicyTier1Gateways(),
+			"nsxt_policy_vms":                       dataSourceNsxtPolicyVMs(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -275,6 +434,9 @@ func Provider() *schema.Provider {
 			"nsxt_ns_service_group":                        resourceNsxtNsServiceGroup(),
 			"nsxt_ns_group":                                resourceNsxtNsGroup(),
 			"nsxt_firewall_section":                        resourceNsxtFirewallSection(),
+			"nsxt_dne_key_policy":                          resourceNsxtDneKeyPolicy(),
+			"nsxt_dne_section":                             resourceNsxtDneSection(),
+			"nsxt_dne_global_config":                       resourceNsxtDneGlobalConfig(),
 			"nsxt_nat_rule":                                resourceNsxtNatRule(),
 			"nsxt_ip_block":                                resourceNsxtIPBlock(),
 			"nsxt_ip_block_subnet":                         resourceNsxtIPBlockSubnet(),
@@ -330,6 +492,25 @@ func Provider() *schema.Provider {
 			"nsxt_policy_ip_pool_static_subnet":            resourceNsxtPolicyIPPoolStaticSubnet(),
 			"nsxt_policy_lb_service":                       resourceNsxtPolicyLBService(),
 			"nsxt_policy_lb_virtual_server":                resourceNsxtPolicyLBVirtualServer(),
+			"nsxt_policy_lb_http_application_profile":      resourceNsxtPolicyLBHTTPApplicationProfile(),
+			"nsxt_policy_lb_fast_tcp_application_profile":  resourceNsxtPolicyLBFastTCPApplicationProfile(),
+			"nsxt_policy_lb_fast_udp_application_profile":  resourceNsxtPolicyLBFastUDPApplicationProfile(),
+			"nsxt_policy_lb_http_monitor_profile":          resourceNsxtPolicyLBHTTPMonitorProfile(),
+			"nsxt_policy_lb_https_monitor_profile":         resourceNsxtPolicyLBHTTPSMonitorProfile(),
+			"nsxt_policy_lb_tcp_monitor_profile":           resourceNsxtPolicyLBTCPMonitorProfile(),
+			"nsxt_policy_lb_udp_monitor_profile":           resourceNsxtPolicyLBUDPMonitorProfile(),
+			"nsxt_policy_lb_icmp_monitor_profile":          resourceNsxtPolicyLBIcmpMonitorProfile(),
+			"nsxt_policy_lb_passive_monitor_profile":       resourceNsxtPolicyLBPassiveMonitorProfile(),
+			"nsxt_policy_lb_cookie_persistence_profile":    resourceNsxtPolicyLBCookiePersistenceProfile(),
+			"nsxt_policy_lb_source_ip_persistence_profile": resourceNsxtPolicyLBSourceIPPersistenceProfile(),
+			"nsxt_policy_lb_generic_persistence_profile":   resourceNsxtPolicyLBGenericPersistenceProfile(),
+			"nsxt_policy_lb_client_ssl_profile":            resourceNsxtPolicyLBClientSslProfile(),
+			"nsxt_policy_lb_server_ssl_profile":            resourceNsxtPolicyLBServerSslProfile(),
+			"nsxt_policy_site":                             resourceNsxtPolicySite(),
+			"nsxt_policy_global_manager":                   resourceNsxtPolicyGlobalManager(),
+			"nsxt_policy_domain_deployment_map":            resourceNsxtPolicyDomainDeploymentMap(),
+			"nsxt_policy_tier0_deployment_map":             resourceNsxtPolicyTier0DeploymentMap(),
+			"nsxt_policy_firewall_draft":                   resourceNsxtPolicyFirewallDraft(),
 			"nsxt_policy_ip_address_allocation":            resourceNsxtPolicyIPAddressAllocation(),
 			"nsxt_policy_bgp_neighbor":                     resourceNsxtPolicyBgpNeighbor(),
 			"nsxt_policy_bgp_config":                       resourceNsxtPolicyBgpConfig(),
@@ -352,9 +533,47 @@ func Provider() *schema.Provider {
 			"nsxt_policy_ospf_config":                      resourceNsxtPolicyOspfConfig(),
 			"nsxt_policy_ospf_area":                        resourceNsxtPolicyOspfArea(),
 			"nsxt_policy_gateway_redistribution_config":    resourceNsxtPolicyGatewayRedistributionConfig(),
+			"nsxt_policy_certificate":                      resourceNsxtPolicyCertificate(),
+			"nsxt_policy_crl":                              resourceNsxtPolicyCrl(),
+			"nsxt_policy_metadata_proxy":                   resourceNsxtPolicyMetadataProxy(),
 		},
 
-		ConfigureFunc: providerConfigure,
+		ConfigureContextFunc: providerConfigure,
+	}
+
+	for _, resource := range provider.ResourcesMap {
+		wrapResourceContextFuncs(resource)
+	}
+	for _, dataSource := range provider.DataSourcesMap {
+		wrapResourceContextFuncs(dataSource)
+	}
+
+	return provider
+}
+
+// Retry settings are shared by MP and policy clients
+func getRetriesConfiguration(d *schema.ResourceData) api.ClientRetriesConfiguration {
+	maxRetries := d.Get("max_retries").(int)
+	retryMinDelay := d.Get("retry_min_delay").(int)
+	retryMaxDelay := d.Get("retry_max_delay").(int)
+
+	statuses := d.Get("retry_on_status_codes").([]interface{})
+	if len(statuses) == 0 {
+		// Set to the defaults if empty
+		for _, val := range defaultRetryOnStatusCodes {
+			statuses = append(statuses, val)
+		}
+	}
+	retryStatuses := make([]int, 0, len(statuses))
+	for _, s := range statuses {
+		retryStatuses = append(retryStatuses, s.(int))
+	}
+
+	return api.ClientRetriesConfiguration{
+		MaxRetries:      maxRetries,
+		RetryMinDelay:   retryMinDelay,
+		RetryMaxDelay:   retryMaxDelay,
+		RetryOnStatuses: retryStatuses,
 	}
 }
 
@@ -413,28 +632,7 @@ func configureNsxtClient(d *schema.ResourceData, clients *nsxtClients) error {
 	caFile := d.Get("ca_file").(string)
 	caString := d.Get("ca").(string)
 
-	maxRetries := d.Get("max_retries").(int)
-	retryMinDelay := d.Get("retry_min_delay").(int)
-	retryMaxDelay := d.Get("retry_max_delay").(int)
-
-	statuses := d.Get("retry_on_status_codes").([]interface{})
-	if len(statuses) == 0 {
-		// Set to the defaults if empty
-		for _, val := range defaultRetryOnStatusCodes {
-			statuses = append(statuses, val)
-		}
-	}
-	retryStatuses := make([]int, 0, len(statuses))
-	for _, s := range statuses {
-		retryStatuses = append(retryStatuses, s.(int))
-	}
-
-	retriesConfig := api.ClientRetriesConfiguration{
-		MaxRetries:      maxRetries,
-		RetryMinDelay:   retryMinDelay,
-		RetryMaxDelay:   retryMaxDelay,
-		RetryOnStatuses: retryStatuses,
-	}
+	retriesConfig := getRetriesConfiguration(d)
 
 	cfg := api.Configuration{
 		BasePath:             "/api/v1",
@@ -454,6 +652,20 @@ func configureNsxtClient(d *schema.ResourceData, clients *nsxtClients) error {
 		RetriesConfiguration: retriesConfig,
 	}
 
+	if clients.CommonConfig.Session != nil {
+		cfg.HTTPClient = &http.Client{Transport: newSessionAuthTransport(clients.CommonConfig.Session.transport, clients.CommonConfig.Session)}
+	} else {
+		tlsConfig, err := getConnectorTLSConfig(d)
+		if err != nil {
+			return err
+		}
+		tr := clients.CommonConfig.HTTPConfig.newTransport(tlsConfig)
+		cfg.HTTPClient = &http.Client{Transport: getProviderTransport(clients.CommonConfig, tr)}
+	}
+	// MP client calls use context without cancellation, and thus are only
+	// interrupted when provider is stopped
+	cfg.HTTPClient.Transport = newContextTransport(cfg.HTTPClient.Transport, clients.Context)
+
 	nsxClient, err := api.NewAPIClient(&cfg)
 	if err != nil {
 		return err
@@ -461,7 +673,7 @@ func configureNsxtClient(d *schema.ResourceData, clients *nsxtClients) error {
 
 	clients.NsxtClient = nsxClient
 
-	return initNSXVersion(nsxClient)
+	return initNSXVersion(clients)
 }
 
 type jwtToken struct {
@@ -473,21 +685,21 @@ type jwtToken struct {
 	RefreshToken string `json:"refresh_token"`
 }
 
-func getAPIToken(vmcAuthHost string, vmcAccessToken string) (string, error) {
+func getAPIToken(httpClient *http.Client, vmcAuthHost string, vmcAccessToken string) (*jwtToken, error) {
 
 	payload := strings.NewReader("refresh_token=" + vmcAccessToken)
 	req, _ := http.NewRequest("POST", "https://"+vmcAuthHost, payload)
 
 	req.Header.Add("content-type", "application/x-www-form-urlencoded")
-	res, err := http.DefaultClient.Do(req)
+	res, err := httpClient.Do(req)
 
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	if res.StatusCode != 200 {
 		b, _ := ioutil.ReadAll(res.Body)
-		return "", fmt.Errorf("Unexpected status code %d trying to get auth token. %s", res.StatusCode, string(b))
+		return nil, fmt.Errorf("Unexpected status code %d trying to get auth token. %s", res.StatusCode, string(b))
 	}
 
 	defer res.Body.Close()
@@ -498,7 +710,7 @@ func getAPIToken(vmcAuthHost string, vmcAccessToken string) (string, error) {
 		log.Printf("[WARNING]: Failed to decode access token from response: %v", err)
 	}
 
-	return token.AccessToken, nil
+	return &token, nil
 }
 
 func getConnectorTLSConfig(d *schema.ResourceData) (*tls.Config, error) {
@@ -602,18 +814,12 @@ func configurePolicyConnectorData(d *schema.ResourceData, clients *nsxtClients)
 				return fmt.Errorf("vmc auth host must be provided if auth token is provided")
 			}
 
-			apiToken, err := getAPIToken(vmcAuthHost, vmcAccessToken)
+			apiToken, err := newVmcAuthToken(getVmcAuthHTTPClient(clients.CommonConfig), vmcAuthHost, vmcAccessToken)
 			if err != nil {
 				return err
 			}
 
-			if vmcAuthMode == "Bearer" {
-				clients.CommonConfig.BearerToken = apiToken
-			} else {
-
-				securityCtx.SetProperty(security.AUTHENTICATION_SCHEME_ID, security.OAUTH_SCHEME_ID)
-				securityCtx.SetProperty(security.ACCESS_TOKEN, apiToken)
-			}
+			clients.CommonConfig.VmcAuthProcessor = newVmcAuthHeaderProcessor(apiToken, vmcAuthMode == "Bearer")
 		} else {
 			if username == "" {
 				return fmt.Errorf("username must be provided")
@@ -623,9 +829,11 @@ func configurePolicyConnectorData(d *schema.ResourceData, clients *nsxtClients)
 				return fmt.Errorf("password must be provided")
 			}
 
-			securityCtx.SetProperty(security.AUTHENTICATION_SCHEME_ID, security.USER_PASSWORD_SCHEME_ID)
-			securityCtx.SetProperty(security.USER_KEY, username)
-			securityCtx.SetProperty(security.PASSWORD_KEY, password)
+			if clients.CommonConfig.Session == nil {
+				securityCtx.SetProperty(security.AUTHENTICATION_SCHEME_ID, security.USER_PASSWORD_SCHEME_ID)
+				securityCtx.SetProperty(security.USER_KEY, username)
+				securityCtx.SetProperty(security.PASSWORD_KEY, password)
+			}
 		}
 	}
 
@@ -634,12 +842,19 @@ func configurePolicyConnectorData(d *schema.ResourceData, clients *nsxtClients)
 		return err
 	}
 
-	tr := &http.Transport{
-		Proxy:           http.ProxyFromEnvironment,
-		TLSClientConfig: tlsConfig,
-	}
+	tr := clients.CommonConfig.HTTPConfig.newTransport(tlsConfig)
 
-	httpClient := http.Client{Transport: tr}
+	transport := getProviderTransport(clients.CommonConfig, tr)
+	if clients.CommonConfig.VmcAuthProcessor != nil {
+		transport = &vmcAuthRetryTransport{
+			transport: transport,
+			processor: clients.CommonConfig.VmcAuthProcessor,
+		}
+	} else if clients.CommonConfig.Session != nil {
+		transport = newSessionAuthTransport(transport, clients.CommonConfig.Session)
+	}
+	transport = newPolicyRetryTransport(transport, getRetriesConfiguration(d), d.Get("retry_on_revision_conflict").(bool))
+	httpClient := http.Client{Transport: transport}
 	clients.PolicyHTTPClient = &httpClient
 	if securityContextNeeded {
 		clients.PolicySecurityContext = securityCtx
@@ -647,38 +862,117 @@ func configurePolicyConnectorData(d *schema.ResourceData, clients *nsxtClients)
 	clients.Host = host
 	clients.PolicyEnforcementPoint = policyEnforcementPoint
 	clients.PolicyGlobalManager = policyGlobalManager
+	if d.Get("firewall_auto_draft").(bool) {
+		clients.PolicyFirewallAutoDraft = &policyFirewallAutoDraft{}
+	}
+	clients.PolicyValidatePaths = d.Get("validate_policy_paths").(bool)
 
 	if (len(vmcAccessToken) > 0) || (vmcAuthMode == "Basic") {
 		// Special treatment for VMC since MP API is not available there
-		initNSXVersionVMC(*clients)
+		initNSXVersionVMC(clients)
 	}
 	return nil
 }
 
-type remoteAuthHeaderProcessor struct {
+func configureHostFailover(d *schema.ResourceData, clients *nsxtClients) error {
+	failoverHosts := interface2StringList(d.Get("failover_hosts").([]interface{}))
+	if len(failoverHosts) == 0 {
+		return nil
+	}
+
+	host := d.Get("host").(string)
+	if host == "" {
+		return fmt.Errorf("host must be provided")
+	}
+
+	tlsConfig, err := getConnectorTLSConfig(d)
+	if err != nil {
+		return err
+	}
+
+	// Health checks authenticate with credentials from configuration, since
+	// MP client and NSX session depend on failover themselves
+	var transport http.RoundTripper = clients.CommonConfig.HTTPConfig.newTransport(tlsConfig)
+	if clients.CommonConfig.APILogger != nil {
+		transport = newAPILoggingTransport(transport, clients.CommonConfig.APILogger)
+	}
+	transport = newCustomHeadersTransport(transport, clients.CommonConfig.HTTPConfig.customHeaders)
+
+	username := d.Get("username").(string)
+	password := d.Get("password").(string)
+	hostFailover, err := newNsxtHostFailover(append([]string{host}, failoverHosts...), transport, username, password, clients.CommonConfig.RemoteAuth)
+	if err != nil {
+		return err
+	}
+
+	clients.CommonConfig.HostFailover = hostFailover
+	return nil
 }
 
-func newRemoteAuthHeaderProcessor() *remoteAuthHeaderProcessor {
-	return &remoteAuthHeaderProcessor{}
+// Base transport of each client is wrapped with API logging, custom headers,
+// rate limiting and host failover, which are shared by all clients. Rate
+// limit applies to each attempt separately, so that requests waiting for
+// failover do not hold the limit needed for host health checks.
+func getProviderTransport(c commonProviderConfig, transport http.RoundTripper) http.RoundTripper {
+	if c.APILogger != nil {
+		transport = newAPILoggingTransport(transport, c.APILogger)
+	}
+	transport = newCustomHeadersTransport(transport, c.HTTPConfig.customHeaders)
+	transport = newRateLimitedTransport(transport, c.RateLimiter)
+	if c.HostFailover != nil {
+		transport = newHostFailoverTransport(transport, c.HostFailover)
+	}
+	return transport
 }
 
-func (processor remoteAuthHeaderProcessor) Process(req *http.Request) error {
-	oldAuthHeader := req.Header.Get("Authorization")
-	newAuthHeader := strings.Replace(oldAuthHeader, "Basic", "Remote", 1)
-	req.Header.Set("Authorization", newAuthHeader)
+// Session is shared by MP and policy clients
+func configureSessionAuth(d *schema.ResourceData, clients *nsxtClients) error {
+	if !d.Get("session_auth").(bool) {
+		return nil
+	}
+
+	host := d.Get("host").(string)
+	username := d.Get("username").(string)
+	password := d.Get("password").(string)
+
+	if len(d.Get("vmc_token").(string)) > 0 {
+		return fmt.Errorf("session_auth is not supported with vmc_token")
+	}
+
+	if host == "" {
+		return fmt.Errorf("host must be provided")
+	}
+
+	if username == "" || password == "" {
+		return fmt.Errorf("username and password must be provided for session_auth")
+	}
+
+	if !strings.HasPrefix(host, "https://") {
+		host = fmt.Sprintf("https://%s", host)
+	}
+
+	tlsConfig, err := getConnectorTLSConfig(d)
+	if err != nil {
+		return err
+	}
+
+	tr := clients.CommonConfig.HTTPConfig.newTransport(tlsConfig)
+
+	transport := getProviderTransport(clients.CommonConfig, tr)
+	clients.CommonConfig.Session = newNsxtSession(host, username, password, clients.CommonConfig.RemoteAuth, transport)
 	return nil
 }
 
-type bearerAuthHeaderProcessor struct {
-	Token string
+type remoteAuthHeaderProcessor struct {
 }
 
-func newBearerAuthHeaderProcessor(token string) *bearerAuthHeaderProcessor {
-	return &bearerAuthHeaderProcessor{Token: token}
+func newRemoteAuthHeaderProcessor() *remoteAuthHeaderProcessor {
+	return &remoteAuthHeaderProcessor{}
 }
 
-func (processor bearerAuthHeaderProcessor) Process(req *http.Request) error {
-	newAuthHeader := fmt.Sprintf("Bearer %s", processor.Token)
+func (processor remoteAuthHeaderProcessor) Process(req *http.Request) error {
+	oldAuthHeader := req.Header.Get("Authorization")
+	newAuthHeader := strings.Replace(oldAuthHeader, "Basic", "Remote", 1)
 	req.Header.Set("Authorization", newAuthHeader)
 	return nil
 }
@@ -711,51 +1005,152 @@ func configureLicenses(d *schema.ResourceData, clients *nsxtClients) error {
 	return nil
 }
 
-func initCommonConfig(d *schema.ResourceData) commonProviderConfig {
+// VMC auth service is not an NSX manager, and thus only shares proxy, TLS
+// version, timeout and header settings with NSX clients
+func getVmcAuthHTTPClient(c commonProviderConfig) *http.Client {
+	tr := c.HTTPConfig.newTransport(&tls.Config{})
+	return &http.Client{Transport: newCustomHeadersTransport(tr, c.HTTPConfig.customHeaders)}
+}
+
+func initCommonConfig(d *schema.ResourceData) (commonProviderConfig, error) {
 	remoteAuth := d.Get("remote_auth").(bool)
 	toleratePartialSuccess := d.Get("tolerate_partial_success").(bool)
+	httpConfig, err := newProviderHTTPConfig(d)
+	if err != nil {
+		return commonProviderConfig{}, err
+	}
+	rateLimiter := newAPIRateLimiter(d.Get("api_rate_limit").(int), d.Get("api_max_concurrency").(int))
+	var customHeaders []string
+	for name := range httpConfig.customHeaders {
+		customHeaders = append(customHeaders, name)
+	}
+	apiLogger := newAPILogger(d.Get("api_debug_logging").(bool), d.Get("api_har_file").(string), customHeaders)
 
 	return commonProviderConfig{
 		RemoteAuth:             remoteAuth,
 		ToleratePartialSuccess: toleratePartialSuccess,
-	}
+		RateLimiter:            rateLimiter,
+		APILogger:              apiLogger,
+		HTTPConfig:             httpConfig,
+	}, nil
 }
 
-func providerConfigure(d *schema.ResourceData) (interface{}, error) {
-	commonConfig := initCommonConfig(d)
+func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	commonConfig, err := initCommonConfig(d)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
 	clients := nsxtClients{
 		CommonConfig: commonConfig,
+		Context:      context.Background(),
+	}
+	if stopCtx, ok := ctx.Value(schema.StopContextKey).(context.Context); ok {
+		clients.Context = stopCtx
 	}
 
-	err := configureNsxtClient(d, &clients)
+	err = configureHostFailover(d, &clients)
 	if err != nil {
-		return nil, err
+		return nil, diag.FromErr(err)
+	}
+
+	err = configureSessionAuth(d, &clients)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	err = configureNsxtClient(d, &clients)
+	if err != nil {
+		return nil, getErrorDiagnostics(err)
 	}
 
 	err = configurePolicyConnectorData(d, &clients)
 	if err != nil {
-		return nil, err
+		return nil, getErrorDiagnostics(err)
 	}
 
 	err = configureLicenses(d, &clients)
 	if err != nil {
-		return nil, err
+		return nil, getErrorDiagnostics(err)
 	}
 
 	return clients, nil
 }
 
+// Returns context of current provider operation
+func getProviderContext(clients interface{}) context.Context {
+	c := clients.(nsxtClients)
+	if c.Context == nil {
+		return context.Background()
+	}
+	return c.Context
+}
+
+// Returns copy of provider clients bound to context of given operation
+func withProviderContext(ctx context.Context, clients interface{}) interface{} {
+	c, ok := clients.(nsxtClients)
+	if !ok {
+		return clients
+	}
+	c.Context = ctx
+	return c
+}
+
+// Context that is cancelled with given operation context, while values
+// are looked up in request context first
+type requestOperationContext struct {
+	context.Context
+	requestCtx context.Context
+}
+
+func (c requestOperationContext) Value(key interface{}) interface{} {
+	if value := c.requestCtx.Value(key); value != nil {
+		return value
+	}
+	return c.Context.Value(key)
+}
+
+// SDK clients do not accept context for policy calls, and use context
+// without cancellation for MP calls. This transport binds such requests
+// to the context of current provider operation.
+type contextTransport struct {
+	transport http.RoundTripper
+	ctx       context.Context
+}
+
+func newContextTransport(transport http.RoundTripper, ctx context.Context) http.RoundTripper {
+	if ctx == nil || ctx.Done() == nil {
+		return transport
+	}
+	return &contextTransport{transport: transport, ctx: ctx}
+}
+
+func (t *contextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Context().Done() != nil {
+		return t.transport.RoundTrip(req)
+	}
+
+	ctx := requestOperationContext{Context: t.ctx, requestCtx: req.Context()}
+	return t.transport.RoundTrip(req.WithContext(ctx))
+}
+
+// Policy SDK clients set per-call REST metadata on the connector before
+// invoking it, thus a connector can not be shared between concurrent calls.
+// A new connector is built for each call, while the HTTP client and its
+// transport chain, with connection pool, session and rate limiter, are
+// shared by the whole provider instance.
 func getPolicyConnector(clients interface{}) *client.RestConnector {
 	c := clients.(nsxtClients)
-	connector := client.NewRestConnector(c.Host, *c.PolicyHTTPClient)
+	httpClient := *c.PolicyHTTPClient
+	httpClient.Transport = newContextTransport(httpClient.Transport, c.Context)
+	connector := client.NewRestConnector(c.Host, httpClient)
 	if c.PolicySecurityContext != nil {
 		connector.SetSecurityContext(c.PolicySecurityContext)
 	}
 	if c.CommonConfig.RemoteAuth {
 		connector.AddRequestProcessor(newRemoteAuthHeaderProcessor())
 	}
-	if len(c.CommonConfig.BearerToken) > 0 {
-		connector.AddRequestProcessor(newBearerAuthHeaderProcessor(c.CommonConfig.BearerToken))
+	if c.CommonConfig.VmcAuthProcessor != nil {
+		connector.AddRequestProcessor(c.CommonConfig.VmcAuthProcessor)
 	}
 
 	return connector
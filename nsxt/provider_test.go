@@ -23,6 +23,9 @@ import (
 var testAccProviders map[string]*schema.Provider
 var testAccProvider *schema.Provider
 
+// NSX version of the test environment, retrieved on demand
+var testAccNsxVersion = ""
+
 func init() {
 
 	testAccProvider = Provider()
@@ -88,42 +91,42 @@ func testAccGetClient() (*api.APIClient, error) {
 }
 
 func testAccNSXVersion(t *testing.T, requiredVersion string) {
-	if nsxVersion == "" {
+	if testAccNsxVersion == "" {
 		client, err := testAccGetClient()
 		if err != nil {
 			t.Skipf("Skipping non-NSX provider. No NSX client")
 			return
 		}
 
-		err = initNSXVersion(client)
+		testAccNsxVersion, err = getNSXVersion(client)
 		if err != nil {
 			t.Errorf("Failed to retrieve NSX version")
 			return
 		}
 	}
 
-	if nsxVersionLower(requiredVersion) {
-		t.Skipf("This test can only run in NSX %s or above (Current version %s)", requiredVersion, nsxVersion)
+	if versionLower(testAccNsxVersion, requiredVersion) {
+		t.Skipf("This test can only run in NSX %s or above (Current version %s)", requiredVersion, testAccNsxVersion)
 	}
 }
 
 func testAccNSXVersionLessThan(t *testing.T, requiredVersion string) {
-	if nsxVersion == "" {
+	if testAccNsxVersion == "" {
 		client, err := testAccGetClient()
 		if err != nil {
 			t.Skipf("Skipping non-NSX provider. No NSX client")
 			return
 		}
 
-		err = initNSXVersion(client)
+		testAccNsxVersion, err = getNSXVersion(client)
 		if err != nil {
 			t.Errorf("Failed to retrieve NSX version")
 			return
 		}
 	}
 
-	if nsxVersionHigherOrEqual(requiredVersion) {
-		t.Skipf("This test can only run in NSX below %s (Current version %s)", requiredVersion, nsxVersion)
+	if versionHigherOrEqual(testAccNsxVersion, requiredVersion) {
+		t.Skipf("This test can only run in NSX below %s (Current version %s)", requiredVersion, testAccNsxVersion)
 	}
 }
 
@@ -0,0 +1,167 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Client side limit of NSX API calls, shared by all clients of a single
+// provider instance. Rate is enforced with token bucket, where bucket size
+// allows a burst of one second worth of requests. In addition, number of
+// requests in flight can be capped. Regardless of limits, API calls are
+// held when NSX asks to retry later.
+type apiRateLimiter struct {
+	mutex        sync.Mutex
+	rate         float64
+	burst        float64
+	tokens       float64
+	last         time.Time
+	blockedUntil time.Time
+	inFlight     chan struct{}
+}
+
+func newAPIRateLimiter(rateLimit int, maxConcurrency int) *apiRateLimiter {
+	limiter := apiRateLimiter{
+		rate:   float64(rateLimit),
+		burst:  float64(rateLimit),
+		tokens: float64(rateLimit),
+		last:   time.Now(),
+	}
+	if maxConcurrency > 0 {
+		limiter.inFlight = make(chan struct{}, maxConcurrency)
+	}
+
+	return &limiter
+}
+
+// Returns how long caller needs to wait before sending the request, and
+// consumes a token if no wait is needed
+func (l *apiRateLimiter) reserve() time.Duration {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := time.Now()
+	if now.Before(l.blockedUntil) {
+		return l.blockedUntil.Sub(now)
+	}
+
+	if l.rate <= 0 {
+		return 0
+	}
+
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+
+	return time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+}
+
+func (l *apiRateLimiter) wait(ctx context.Context) error {
+	for {
+		delay := l.reserve()
+		if delay == 0 {
+			break
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	if l.inFlight != nil {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case l.inFlight <- struct{}{}:
+		}
+	}
+
+	return nil
+}
+
+func (l *apiRateLimiter) done() {
+	if l.inFlight != nil {
+		<-l.inFlight
+	}
+}
+
+// Holds all requests until the time requested by NSX
+func (l *apiRateLimiter) block(delay time.Duration) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	until := time.Now().Add(delay)
+	if until.After(l.blockedUntil) {
+		log.Printf("[INFO] NSX requested to hold API calls for %v", delay)
+		l.blockedUntil = until
+	}
+}
+
+// Retry-After is specified either in seconds or as HTTP date
+func getRetryAfterDelay(resp *http.Response) time.Duration {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds > 0 {
+			return time.Duration(seconds) * time.Second
+		}
+		return 0
+	}
+
+	if date, err := http.ParseTime(value); err == nil && time.Now().Before(date) {
+		return time.Until(date)
+	}
+
+	return 0
+}
+
+type rateLimitedTransport struct {
+	transport http.RoundTripper
+	limiter   *apiRateLimiter
+}
+
+func newRateLimitedTransport(transport http.RoundTripper, limiter *apiRateLimiter) http.RoundTripper {
+	return &rateLimitedTransport{transport: transport, limiter: limiter}
+}
+
+func (t *rateLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	err := t.limiter.wait(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	defer t.limiter.done()
+
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+		if delay := getRetryAfterDelay(resp); delay > 0 {
+			t.limiter.block(delay)
+		}
+	}
+
+	return resp, nil
+}
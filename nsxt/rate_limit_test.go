@@ -0,0 +1,150 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestAPIRateLimiterReserve(t *testing.T) {
+	limiter := newAPIRateLimiter(10, 0)
+
+	// Burst of one second worth of requests is allowed
+	for i := 0; i < 10; i++ {
+		if delay := limiter.reserve(); delay != 0 {
+			t.Fatalf("Expected no delay for request %d within burst, got %v", i, delay)
+		}
+	}
+
+	delay := limiter.reserve()
+	if delay <= 0 || delay > 100*time.Millisecond {
+		t.Errorf("Expected delay of up to 100ms once burst is exhausted, got %v", delay)
+	}
+
+	// Tokens are replenished over time
+	limiter.last = limiter.last.Add(-200 * time.Millisecond)
+	if delay := limiter.reserve(); delay != 0 {
+		t.Errorf("Expected no delay after tokens were replenished, got %v", delay)
+	}
+}
+
+func TestAPIRateLimiterReserveNoLimit(t *testing.T) {
+	limiter := newAPIRateLimiter(0, 0)
+	for i := 0; i < 100; i++ {
+		if delay := limiter.reserve(); delay != 0 {
+			t.Fatalf("Expected no delay without rate limit, got %v", delay)
+		}
+	}
+}
+
+func TestAPIRateLimiterBlock(t *testing.T) {
+	limiter := newAPIRateLimiter(0, 0)
+	limiter.block(time.Second)
+	// Shorter hold does not release requests earlier
+	limiter.block(10 * time.Millisecond)
+
+	delay := limiter.reserve()
+	if delay <= 500*time.Millisecond || delay > time.Second {
+		t.Errorf("Expected delay of up to 1s while blocked, got %v", delay)
+	}
+
+	limiter.blockedUntil = time.Now().Add(-time.Millisecond)
+	if delay := limiter.reserve(); delay != 0 {
+		t.Errorf("Expected no delay once hold expired, got %v", delay)
+	}
+}
+
+func TestAPIRateLimiterWaitCancelled(t *testing.T) {
+	limiter := newAPIRateLimiter(0, 1)
+	err := limiter.wait(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Second request waits for the first one to be done
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err = limiter.wait(ctx)
+	if err != context.DeadlineExceeded {
+		t.Errorf("Expected wait to be cancelled, got %v", err)
+	}
+
+	limiter.done()
+	err = limiter.wait(context.Background())
+	if err != nil {
+		t.Errorf("Expected request to proceed once in flight request is done, got %v", err)
+	}
+
+	limiter.block(time.Hour)
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err = limiter.wait(ctx)
+	if err != context.DeadlineExceeded {
+		t.Errorf("Expected wait for blocked limiter to be cancelled, got %v", err)
+	}
+}
+
+func TestGetRetryAfterDelay(t *testing.T) {
+	cases := []struct {
+		name     string
+		value    string
+		min      time.Duration
+		max      time.Duration
+		expected time.Duration
+	}{
+		{name: "missing", value: ""},
+		{name: "seconds", value: "5", expected: 5 * time.Second},
+		{name: "zero", value: "0"},
+		{name: "negative", value: "-3"},
+		{name: "invalid", value: "soon"},
+		{name: "date", value: time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat), min: 8 * time.Second, max: 10 * time.Second},
+		{name: "past date", value: time.Now().Add(-10 * time.Second).UTC().Format(http.TimeFormat)},
+	}
+
+	for _, tc := range cases {
+		resp := &http.Response{Header: make(http.Header)}
+		if tc.value != "" {
+			resp.Header.Set("Retry-After", tc.value)
+		}
+		delay := getRetryAfterDelay(resp)
+		if tc.max > 0 {
+			if delay < tc.min || delay > tc.max {
+				t.Errorf("%s: expected delay between %v and %v, got %v", tc.name, tc.min, tc.max, delay)
+			}
+		} else if delay != tc.expected {
+			t.Errorf("%s: expected delay %v, got %v", tc.name, tc.expected, delay)
+		}
+	}
+}
+
+func TestRateLimitedTransportRetryAfter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	limiter := newAPIRateLimiter(0, 1)
+	transport := newRateLimitedTransport(server.Client().Transport, limiter)
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("Expected status %d to be returned as is, got %d", http.StatusTooManyRequests, resp.StatusCode)
+	}
+	if delay := limiter.reserve(); delay <= 25*time.Second {
+		t.Errorf("Expected API calls to be held for 30s, got %v", delay)
+	}
+	if len(limiter.inFlight) != 0 {
+		t.Errorf("Expected in flight slot to be released, got %d in flight", len(limiter.inFlight))
+	}
+}
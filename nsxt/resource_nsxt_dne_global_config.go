@@ -0,0 +1,168 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	api "github.com/vmware/go-vmware-nsxt"
+)
+
+// DNE global config is a singleton on NSX, hence a constant ID is used in state
+const dneGlobalConfigID = "dne_global_config"
+const dneStatusContext = "east_west"
+const dneStatusEnabled = "ENABLED"
+const dneStatusDisabled = "DISABLED"
+
+const dneDefaultRekeyMarginTime = 1
+
+func resourceNsxtDneGlobalConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtDneGlobalConfigCreate,
+		Read:   resourceNsxtDneGlobalConfigRead,
+		Update: resourceNsxtDneGlobalConfigUpdate,
+		Delete: resourceNsxtDneGlobalConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: nsxVersionCustomizeDiff("3.0.0"),
+
+		Schema: map[string]*schema.Schema{
+			"revision": getRevisionSchema(),
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Flag to enable distributed network encryption for east-west traffic",
+				Required:    true,
+			},
+			"allow_mirrored": {
+				Type:        schema.TypeBool,
+				Description: "Flag which reflects whether DNE protected east-west traffic will be dropped at mirroring stage",
+				Optional:    true,
+				Default:     false,
+			},
+			"rekey_margin_time": {
+				Type:         schema.TypeInt,
+				Description:  "Time period in minutes during which both old and new keys are valid",
+				Optional:     true,
+				Default:      dneDefaultRekeyMarginTime,
+				ValidateFunc: validation.IntBetween(1, 4),
+			},
+		},
+	}
+}
+
+func setDneStatus(nsxClient *api.APIClient, enabled bool) error {
+	status := dneStatusDisabled
+	if enabled {
+		status = dneStatusEnabled
+	}
+
+	localVarOptionals := make(map[string]interface{})
+	localVarOptionals["status"] = status
+	_, resp, err := nsxClient.ServicesApi.UpdateNetworkEncryptionStatusUpdateStatus(nsxClient.Context, dneStatusContext, localVarOptionals)
+	if err != nil {
+		return fmt.Errorf("Error during DNE status update: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("Unexpected status returned during DNE status update: %v", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func updateDneGlobalConfig(nsxClient *api.APIClient, enabled bool, allowMirrored bool, rekeyMarginTime int64) error {
+	// Revision is taken from NSX since the object is never created by terraform
+	config, _, err := nsxClient.ServicesApi.GetDneGlobalConfig(nsxClient.Context)
+	if err != nil {
+		return fmt.Errorf("Error during DneGlobalConfig read: %v", err)
+	}
+
+	config.AllowMirrored = allowMirrored
+	config.RekeyMarginTime = rekeyMarginTime
+	_, resp, err := nsxClient.ServicesApi.UpdateDneGlobalConfig(nsxClient.Context, config)
+	if err != nil {
+		return fmt.Errorf("Error during DneGlobalConfig update: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("Unexpected status returned during DneGlobalConfig update: %v", resp.StatusCode)
+	}
+
+	return setDneStatus(nsxClient, enabled)
+}
+
+func resourceNsxtDneGlobalConfigCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	enabled := d.Get("enabled").(bool)
+	allowMirrored := d.Get("allow_mirrored").(bool)
+	rekeyMarginTime := int64(d.Get("rekey_margin_time").(int))
+	err := updateDneGlobalConfig(nsxClient, enabled, allowMirrored, rekeyMarginTime)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(dneGlobalConfigID)
+
+	return resourceNsxtDneGlobalConfigRead(d, m)
+}
+
+func resourceNsxtDneGlobalConfigRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	config, _, err := nsxClient.ServicesApi.GetDneGlobalConfig(nsxClient.Context)
+	if err != nil {
+		return fmt.Errorf("Error during DneGlobalConfig read: %v", err)
+	}
+
+	status, _, err := nsxClient.ServicesApi.GetNetworkEncryptionStatus(nsxClient.Context)
+	if err != nil {
+		return fmt.Errorf("Error during DNE status read: %v", err)
+	}
+
+	d.Set("revision", config.Revision)
+	d.Set("allow_mirrored", config.AllowMirrored)
+	d.Set("rekey_margin_time", config.RekeyMarginTime)
+	d.Set("enabled", strings.EqualFold(status.Status, dneStatusEnabled))
+
+	return nil
+}
+
+func resourceNsxtDneGlobalConfigUpdate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	enabled := d.Get("enabled").(bool)
+	allowMirrored := d.Get("allow_mirrored").(bool)
+	rekeyMarginTime := int64(d.Get("rekey_margin_time").(int))
+	err := updateDneGlobalConfig(nsxClient, enabled, allowMirrored, rekeyMarginTime)
+	if err != nil {
+		return err
+	}
+
+	return resourceNsxtDneGlobalConfigRead(d, m)
+}
+
+func resourceNsxtDneGlobalConfigDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	// The object can not be deleted, hence DNE is disabled and defaults are restored
+	return updateDneGlobalConfig(nsxClient, false, false, dneDefaultRekeyMarginTime)
+}
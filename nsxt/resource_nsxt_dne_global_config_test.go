@@ -0,0 +1,71 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtDneGlobalConfig_basic(t *testing.T) {
+	testResourceName := "nsxt_dne_global_config.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccTestMP(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXDneGlobalConfigCheckDisabled()
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXDneGlobalConfigTemplate(true, false, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "allow_mirrored", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "rekey_margin_time", "2"),
+				),
+			},
+			{
+				Config: testAccNSXDneGlobalConfigTemplate(false, true, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "allow_mirrored", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "rekey_margin_time", "1"),
+				),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateId:     dneGlobalConfigID,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNSXDneGlobalConfigCheckDisabled() error {
+	nsxClient := testAccProvider.Meta().(nsxtClients).NsxtClient
+	status, _, err := nsxClient.ServicesApi.GetNetworkEncryptionStatus(nsxClient.Context)
+	if err != nil {
+		return fmt.Errorf("Error while retrieving DNE status: %v", err)
+	}
+
+	if strings.EqualFold(status.Status, dneStatusEnabled) {
+		return fmt.Errorf("DNE is still enabled")
+	}
+	return nil
+}
+
+func testAccNSXDneGlobalConfigTemplate(enabled bool, allowMirrored bool, rekeyMarginTime int) string {
+	return fmt.Sprintf(`
+resource "nsxt_dne_global_config" "test" {
+  enabled           = %t
+  allow_mirrored    = %t
+  rekey_margin_time = %d
+}`, enabled, allowMirrored, rekeyMarginTime)
+}
@@ -0,0 +1,189 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vmware-nsxt/manager"
+)
+
+func resourceNsxtDneKeyPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtDneKeyPolicyCreate,
+		Read:   resourceNsxtDneKeyPolicyRead,
+		Update: resourceNsxtDneKeyPolicyUpdate,
+		Delete: resourceNsxtDneKeyPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: nsxVersionCustomizeDiff("3.0.0"),
+
+		Schema: map[string]*schema.Schema{
+			"revision": getRevisionSchema(),
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Description of this resource",
+				Optional:    true,
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Description: "The display name of this resource. Defaults to ID if not set",
+				Optional:    true,
+				Computed:    true,
+			},
+			"tag": getTagsSchema(),
+			"encrypt_algorithm": {
+				Type:        schema.TypeString,
+				Description: "Encryption algorithm of the key policy",
+				Required:    true,
+			},
+			"encrypt_type": {
+				Type:        schema.TypeString,
+				Description: "Encryption type of the key policy",
+				Required:    true,
+			},
+			"mac_algorithm": {
+				Type:        schema.TypeString,
+				Description: "MAC algorithm of the key policy",
+				Required:    true,
+			},
+			"rekey_frequency": {
+				Type:         schema.TypeInt,
+				Description:  "Frequency of key policy rekey in seconds",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(86400, 864000000),
+			},
+			"notes": {
+				Type:        schema.TypeString,
+				Description: "User notes specific to the key policy",
+				Optional:    true,
+			},
+			"is_default": {
+				Type:        schema.TypeBool,
+				Description: "A boolean flag which reflects whether this key policy is the default one",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func getDneKeyPolicyFromSchema(d *schema.ResourceData) manager.DneKeyPolicy {
+	return manager.DneKeyPolicy{
+		Revision:         int64(d.Get("revision").(int)),
+		Description:      d.Get("description").(string),
+		DisplayName:      d.Get("display_name").(string),
+		Tags:             getTagsFromSchema(d),
+		EncryptAlgorithm: d.Get("encrypt_algorithm").(string),
+		EncryptType:      d.Get("encrypt_type").(string),
+		MacAlgorithm:     d.Get("mac_algorithm").(string),
+		RekeyFrequency:   int64(d.Get("rekey_frequency").(int)),
+		Notes:            d.Get("notes").(string),
+	}
+}
+
+func resourceNsxtDneKeyPolicyCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	keyPolicy := getDneKeyPolicyFromSchema(d)
+	keyPolicy, resp, err := nsxClient.ServicesApi.AddDneKeyPolicy(nsxClient.Context, keyPolicy)
+
+	if err != nil {
+		return fmt.Errorf("Error during DneKeyPolicy create: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("Unexpected status returned during DneKeyPolicy create: %v", resp.StatusCode)
+	}
+	d.SetId(keyPolicy.Id)
+
+	return resourceNsxtDneKeyPolicyRead(d, m)
+}
+
+func resourceNsxtDneKeyPolicyRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	keyPolicy, resp, err := nsxClient.ServicesApi.GetDneKeyPolicy(nsxClient.Context, id)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] DneKeyPolicy %s not found", id)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during DneKeyPolicy read: %v", err)
+	}
+
+	d.Set("revision", keyPolicy.Revision)
+	d.Set("description", keyPolicy.Description)
+	d.Set("display_name", keyPolicy.DisplayName)
+	setTagsInSchema(d, keyPolicy.Tags)
+	d.Set("encrypt_algorithm", keyPolicy.EncryptAlgorithm)
+	d.Set("encrypt_type", keyPolicy.EncryptType)
+	d.Set("mac_algorithm", keyPolicy.MacAlgorithm)
+	d.Set("rekey_frequency", keyPolicy.RekeyFrequency)
+	d.Set("notes", keyPolicy.Notes)
+	d.Set("is_default", keyPolicy.IsDefault)
+
+	return nil
+}
+
+func resourceNsxtDneKeyPolicyUpdate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	keyPolicy := getDneKeyPolicyFromSchema(d)
+	_, resp, err := nsxClient.ServicesApi.UpdateDneKeyPolicy(nsxClient.Context, id, keyPolicy)
+
+	if err != nil || resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("Error during DneKeyPolicy update: %v", err)
+	}
+
+	return resourceNsxtDneKeyPolicyRead(d, m)
+}
+
+func resourceNsxtDneKeyPolicyDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	resp, err := nsxClient.ServicesApi.DeleteDneKeyPolicy(nsxClient.Context, id)
+	if err != nil {
+		return fmt.Errorf("Error during DneKeyPolicy delete: %v", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] DneKeyPolicy %s not found", id)
+		d.SetId("")
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtDneKeyPolicy_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updateName := getAccTestResourceName()
+	testResourceName := "nsxt_dne_key_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccTestMP(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXDneKeyPolicyCheckDestroy(state, updateName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXDneKeyPolicyCreateTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXDneKeyPolicyExists(name, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "encrypt_type", "ENCRYPT_AND_INTEGRITY"),
+					resource.TestCheckResourceAttr(testResourceName, "rekey_frequency", "86400"),
+					resource.TestCheckResourceAttr(testResourceName, "is_default", "false"),
+				),
+			},
+			{
+				Config: testAccNSXDneKeyPolicyUpdateTemplate(updateName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXDneKeyPolicyExists(updateName, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updateName),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test Update"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "encrypt_type", "INTEGRITY_ONLY"),
+					resource.TestCheckResourceAttr(testResourceName, "rekey_frequency", "172800"),
+					resource.TestCheckResourceAttr(testResourceName, "notes", "rotated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtDneKeyPolicy_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_dne_key_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccTestMP(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXDneKeyPolicyCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXDneKeyPolicyCreateTemplate(name),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNSXDneKeyPolicyExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		nsxClient := testAccProvider.Meta().(nsxtClients).NsxtClient
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("DNE Key Policy resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("DNE Key Policy resource ID not set in resources ")
+		}
+
+		keyPolicy, responseCode, err := nsxClient.ServicesApi.GetDneKeyPolicy(nsxClient.Context, resourceID)
+		if err != nil {
+			return fmt.Errorf("Error while retrieving DNE Key Policy ID %s. Error: %v", resourceID, err)
+		}
+
+		if responseCode.StatusCode != http.StatusOK {
+			return fmt.Errorf("Error while checking if DNE Key Policy %s exists. HTTP return code was %d", resourceID, responseCode.StatusCode)
+		}
+
+		if displayName == keyPolicy.DisplayName {
+			return nil
+		}
+		return fmt.Errorf("DNE Key Policy %s wasn't found", displayName)
+	}
+}
+
+func testAccNSXDneKeyPolicyCheckDestroy(state *terraform.State, displayName string) error {
+	nsxClient := testAccProvider.Meta().(nsxtClients).NsxtClient
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_dne_key_policy" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		keyPolicy, responseCode, err := nsxClient.ServicesApi.GetDneKeyPolicy(nsxClient.Context, resourceID)
+		if err != nil {
+			if responseCode.StatusCode != http.StatusOK {
+				return nil
+			}
+			return fmt.Errorf("Error while retrieving DNE Key Policy ID %s. Error: %v", resourceID, err)
+		}
+
+		if displayName == keyPolicy.DisplayName {
+			return fmt.Errorf("DNE Key Policy %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNSXDneKeyPolicyCreateTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_dne_key_policy" "test" {
+  display_name      = "%s"
+  description       = "Acceptance Test"
+  encrypt_algorithm = "AES_GCM_128"
+  encrypt_type      = "ENCRYPT_AND_INTEGRITY"
+  mac_algorithm     = "GMAC_128"
+  rekey_frequency   = 86400
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name)
+}
+
+func testAccNSXDneKeyPolicyUpdateTemplate(updatedName string) string {
+	return fmt.Sprintf(`
+resource "nsxt_dne_key_policy" "test" {
+  display_name      = "%s"
+  description       = "Acceptance Test Update"
+  encrypt_algorithm = "AES_GCM_128"
+  encrypt_type      = "INTEGRITY_ONLY"
+  mac_algorithm     = "GMAC_128"
+  rekey_frequency   = 172800
+  notes             = "rotated"
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+
+  tag {
+    scope = "scope2"
+    tag   = "tag2"
+  }
+}`, updatedName)
+}
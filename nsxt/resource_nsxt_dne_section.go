@@ -0,0 +1,397 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vmware-nsxt/manager"
+)
+
+func resourceNsxtDneSection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtDneSectionCreate,
+		Read:   resourceNsxtDneSectionRead,
+		Update: resourceNsxtDneSectionUpdate,
+		Delete: resourceNsxtDneSectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: nsxVersionCustomizeDiff("3.0.0"),
+
+		Schema: map[string]*schema.Schema{
+			"revision": getRevisionSchema(),
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Description of this resource",
+				Optional:    true,
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Description: "The display name of this resource. Defaults to ID if not set",
+				Optional:    true,
+				Computed:    true,
+			},
+			"tag": getTagsSchema(),
+			"is_default": {
+				Type:        schema.TypeBool,
+				Description: "A boolean flag which reflects whether a DNE section is default section or not",
+				Computed:    true,
+			},
+			"section_type": {
+				Type:        schema.TypeString,
+				Description: "Type of the rules which a section can contain",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"insert_before": {
+				Type:        schema.TypeString,
+				Description: "Id of section that should come after this one",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"rule": getDneRulesSchema(),
+		},
+	}
+}
+
+func getDneRulesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "List of DNE rules in the section. Rules are enforced in the order in which they appear",
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:        schema.TypeString,
+					Description: "ID of this resource",
+					Computed:    true,
+				},
+				"revision": getRevisionSchema(),
+				"description": {
+					Type:        schema.TypeString,
+					Description: "Description of this resource",
+					Optional:    true,
+				},
+				"display_name": {
+					Type:        schema.TypeString,
+					Description: "Defaults to ID if not set",
+					Optional:    true,
+				},
+				"action": {
+					Type:        schema.TypeString,
+					Description: "Type of protection provided by the key policy of this rule",
+					Computed:    true,
+				},
+				"key_policy_id": {
+					Type:        schema.TypeString,
+					Description: "Identifier of DNE key policy used by this rule",
+					Optional:    true,
+				},
+				"applied_to":  getResourceReferencesSetSchema(false, false, []string{"LogicalPort", "LogicalSwitch", "NSGroup"}, "List of objects where rule will be enforced. Null will be treated as any"),
+				"destination": getResourceReferencesSetSchema(false, false, []string{"IPSet", "LogicalPort", "LogicalSwitch", "NSGroup"}, "List of the destinations. Null will be treated as any"),
+				"disabled": {
+					Type:        schema.TypeBool,
+					Description: "Flag to disable rule. Disabled will only be persisted but never provisioned/realized",
+					Optional:    true,
+				},
+				"ip_protocol": {
+					Type:         schema.TypeString,
+					Description:  "Type of IP packet that should be matched while enforcing the rule (IPV4, IPV6, IPV4_IPV6)",
+					Optional:     true,
+					Default:      "IPV4_IPV6",
+					ValidateFunc: validation.StringInSlice(firewallRuleIPProtocolValues, false),
+				},
+				"logged": {
+					Type:        schema.TypeBool,
+					Description: "Flag to enable packet logging. Default is disabled",
+					Optional:    true,
+				},
+				"comments": {
+					Type:        schema.TypeString,
+					Description: "User notes specific to the rule",
+					Optional:    true,
+				},
+				"rule_tag": {
+					Type:        schema.TypeString,
+					Description: "User level field which will be printed in CLI and packet logs",
+					Optional:    true,
+				},
+				"source":  getResourceReferencesSetSchema(false, false, []string{"IPSet", "LogicalPort", "LogicalSwitch", "NSGroup"}, "List of sources. Null will be treated as any"),
+				"service": getResourceReferencesSetSchema(false, false, []string{"NSService", "NSServiceGroup"}, "List of the services. Null will be treated as any"),
+			},
+		},
+	}
+}
+
+func returnDneServicesResourceReferences(services []manager.DneService) *schema.Set {
+	var servicesList []interface{}
+	for _, srv := range services {
+		elem := make(map[string]interface{})
+		elem["is_valid"] = srv.IsValid
+		elem["target_display_name"] = srv.TargetDisplayName
+		elem["target_id"] = srv.TargetId
+		elem["target_type"] = srv.TargetType
+		servicesList = append(servicesList, elem)
+	}
+	s := schema.NewSet(resourceReferenceHash, servicesList)
+	return s
+}
+
+func setDneRulesInSchema(d *schema.ResourceData, rules []manager.DneRule) error {
+	var rulesList []map[string]interface{}
+	for _, rule := range rules {
+		elem := make(map[string]interface{})
+		elem["id"] = rule.Id
+		elem["display_name"] = rule.DisplayName
+		elem["description"] = rule.Description
+		elem["rule_tag"] = rule.RuleTag
+		elem["comments"] = rule.Comments
+		elem["logged"] = rule.Logged
+		elem["action"] = rule.Action
+		elem["key_policy_id"] = rule.KeyPolicyIdentifier
+		elem["ip_protocol"] = rule.IpProtocol
+		elem["disabled"] = rule.Disabled
+		elem["revision"] = rule.Revision
+		elem["source"] = returnResourceReferencesSet(rule.Sources)
+		elem["destination"] = returnResourceReferencesSet(rule.Destinations)
+		elem["service"] = returnDneServicesResourceReferences(rule.Services)
+		elem["applied_to"] = returnResourceReferencesSet(rule.AppliedTos)
+
+		rulesList = append(rulesList, elem)
+	}
+	err := d.Set("rule", rulesList)
+	return err
+}
+
+func getDneServicesResourceReferences(services []interface{}) []manager.DneService {
+	var servicesList []manager.DneService
+	for _, srv := range services {
+		data := srv.(map[string]interface{})
+		elem := manager.DneService{
+			IsValid:           data["is_valid"].(bool),
+			TargetDisplayName: data["target_display_name"].(string),
+			TargetId:          data["target_id"].(string),
+			TargetType:        data["target_type"].(string),
+		}
+		servicesList = append(servicesList, elem)
+	}
+	return servicesList
+}
+
+func getDneRulesFromSchema(d *schema.ResourceData) []manager.DneRule {
+	rules := d.Get("rule").([]interface{})
+	var ruleList []manager.DneRule
+	for _, rule := range rules {
+		data := rule.(map[string]interface{})
+		elem := manager.DneRule{
+			DisplayName:         data["display_name"].(string),
+			Id:                  data["id"].(string),
+			RuleTag:             data["rule_tag"].(string),
+			Comments:            data["comments"].(string),
+			Description:         data["description"].(string),
+			KeyPolicyIdentifier: data["key_policy_id"].(string),
+			Logged:              data["logged"].(bool),
+			Disabled:            data["disabled"].(bool),
+			Revision:            int64(data["revision"].(int)),
+			IpProtocol:          data["ip_protocol"].(string),
+			Sources:             getResourceReferences(data["source"].(*schema.Set).List()),
+			Destinations:        getResourceReferences(data["destination"].(*schema.Set).List()),
+			Services:            getDneServicesResourceReferences(data["service"].(*schema.Set).List()),
+			AppliedTos:          getResourceReferences(data["applied_to"].(*schema.Set).List()),
+		}
+
+		ruleList = append(ruleList, elem)
+	}
+	return ruleList
+}
+
+func resourceNsxtDneSectionCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	rules := getDneRulesFromSchema(d)
+	description := d.Get("description").(string)
+	displayName := d.Get("display_name").(string)
+	tags := getTagsFromSchema(d)
+	sectionType := d.Get("section_type").(string)
+	insertBefore := d.Get("insert_before").(string)
+	dneSection := manager.DneSectionRuleList{
+		Description: description,
+		DisplayName: displayName,
+		Tags:        tags,
+		SectionType: sectionType,
+		Rules:       rules,
+	}
+
+	operation := "insert_top"
+	localVarOptionals := make(map[string]interface{})
+	if insertBefore != "" {
+		operation = "insert_before"
+		localVarOptionals["id"] = insertBefore
+	}
+
+	var resp *http.Response
+	var err error
+	if len(rules) == 0 {
+		section := manager.DneSection{
+			Description: description,
+			DisplayName: displayName,
+			Tags:        tags,
+			SectionType: sectionType,
+		}
+		section, resp, err = nsxClient.ServicesApi.AddDneSection(nsxClient.Context, section, operation, localVarOptionals)
+		d.SetId(section.Id)
+	} else {
+		dneSection, resp, err = nsxClient.ServicesApi.AddDneSectionWithRulesCreateWithRules(nsxClient.Context, dneSection, operation, localVarOptionals)
+		d.SetId(dneSection.Id)
+	}
+
+	if err != nil {
+		return fmt.Errorf("Error during DneSection create with rules: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("Unexpected status returned during DneSection create with rules: %v", resp.StatusCode)
+	}
+
+	return resourceNsxtDneSectionRead(d, m)
+}
+
+func resourceNsxtDneSectionRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	dneSection, resp, err := nsxClient.ServicesApi.GetDneSectionWithRulesListWithRules(nsxClient.Context, id)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] DneSection %s not found", id)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during DneSection %s read: %v", id, err)
+	}
+
+	d.Set("revision", dneSection.Revision)
+	d.Set("description", dneSection.Description)
+	d.Set("display_name", dneSection.DisplayName)
+	d.Set("is_default", dneSection.IsDefault)
+	d.Set("section_type", dneSection.SectionType)
+	setTagsInSchema(d, dneSection.Tags)
+	err = setDneRulesInSchema(d, dneSection.Rules)
+	if err != nil {
+		return fmt.Errorf("Error during DneSection rules set in schema: %v", err)
+	}
+
+	return nil
+}
+
+func resourceNsxtDneSectionUpdate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	rules := getDneRulesFromSchema(d)
+	revision := int64(d.Get("revision").(int))
+	description := d.Get("description").(string)
+	displayName := d.Get("display_name").(string)
+	tags := getTagsFromSchema(d)
+	sectionType := d.Get("section_type").(string)
+
+	var resp *http.Response
+	var err error
+	if len(rules) == 0 {
+		section := manager.DneSection{
+			Revision:    revision,
+			Description: description,
+			DisplayName: displayName,
+			Tags:        tags,
+			SectionType: sectionType,
+			Id:          id,
+		}
+		// Update the section ignoring the rules
+		_, resp, err = nsxClient.ServicesApi.UpdateDneSection(nsxClient.Context, id, section)
+		if err != nil || resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Error during DneSection %s update: %v", id, err)
+		}
+
+		// Read the section, and delete all current rules from it
+		currSection, resp2, err2 := nsxClient.ServicesApi.GetDneSectionWithRulesListWithRules(nsxClient.Context, id)
+		if resp2 != nil && resp2.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("DneSection %s not found during update empty action", id)
+		}
+		if err2 != nil {
+			return fmt.Errorf("Error during DneSection %s update empty: cannot read the section: %v", id, err2)
+		}
+		for _, rule := range currSection.Rules {
+			_, err3 := nsxClient.ServicesApi.DeleteDneRule(nsxClient.Context, id, rule.Id)
+			if err3 != nil {
+				return fmt.Errorf("Error during DneSection %s update: failed to delete rule %s due to %v", id, rule.Id, err3)
+			}
+		}
+	} else {
+		// Rules are replaced as a whole, preserving the order given in configuration
+		dneSection := manager.DneSectionRuleList{
+			Revision:    revision,
+			Description: description,
+			DisplayName: displayName,
+			Tags:        tags,
+			SectionType: sectionType,
+			Id:          id,
+			Rules:       rules,
+		}
+		_, resp, err = nsxClient.ServicesApi.UpdateDneSectionWithRulesUpdateWithRules(nsxClient.Context, id, dneSection)
+		if err != nil || resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Error during DneSection %s update: %v", id, err)
+		}
+	}
+
+	return resourceNsxtDneSectionRead(d, m)
+}
+
+func resourceNsxtDneSectionDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id to delete")
+	}
+
+	// Operation is only relevant for section placement, and is ignored on delete
+	localVarOptionals := make(map[string]interface{})
+	localVarOptionals["cascade"] = true
+	resp, err := nsxClient.ServicesApi.DeleteDneSection(nsxClient.Context, id, "insert_top", localVarOptionals)
+	if err != nil {
+		return fmt.Errorf("Error during DneSection %s delete: %v", id, err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] DneSection %s not found", id)
+		d.SetId("")
+	}
+	return nil
+}
@@ -0,0 +1,330 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtDneSection_basic(t *testing.T) {
+	sectionName := getAccTestResourceName()
+	updatedSectionName := getAccTestResourceName()
+	testResourceName := "nsxt_dne_section.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccTestMP(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXDneSectionCheckDestroy(state, updatedSectionName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXDneSectionCreateEmptyTemplate(sectionName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXDneSectionExists(sectionName, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", sectionName),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNSXDneSectionUpdateEmptyTemplate(updatedSectionName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXDneSectionExists(updatedSectionName, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedSectionName),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test Update"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtDneSection_withRules(t *testing.T) {
+	sectionName := getAccTestResourceName()
+	testResourceName := "nsxt_dne_section.test"
+	ruleName := "rule1.0"
+	updatedRuleName := "rule1.1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccTestMP(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXDneSectionCheckDestroy(state, sectionName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXDneSectionCreateTemplate(sectionName, ruleName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXDneSectionExists(sectionName, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", sectionName),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.display_name", ruleName),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.source.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.destination.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.service.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "rule.0.key_policy_id"),
+				),
+			},
+			{
+				Config: testAccNSXDneSectionUpdateTemplate(sectionName, updatedRuleName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXDneSectionExists(sectionName, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", sectionName),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.display_name", "rule2"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.1.display_name", updatedRuleName),
+					resource.TestCheckResourceAttr(testResourceName, "rule.1.ip_protocol", "IPV4"),
+				),
+			},
+			{
+				Config: testAccNSXDneSectionUpdateEmptyTemplate(sectionName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXDneSectionExists(sectionName, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtDneSection_ordered(t *testing.T) {
+	sectionNames := [3]string{getAccTestResourceName(), getAccTestResourceName(), getAccTestResourceName()}
+	testResourceNames := [3]string{"nsxt_dne_section.test1", "nsxt_dne_section.test2", "nsxt_dne_section.test3"}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccTestMP(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			for i := 0; i <= 2; i++ {
+				err := testAccNSXDneSectionCheckDestroy(state, sectionNames[i])
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXDneSectionOrderedTemplate(sectionNames),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXDneSectionExists(sectionNames[0], testResourceNames[0]),
+					testAccNSXDneSectionExists(sectionNames[1], testResourceNames[1]),
+					testAccNSXDneSectionExists(sectionNames[2], testResourceNames[2]),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtDneSection_importWithRules(t *testing.T) {
+	sectionName := getAccTestResourceName()
+	testResourceName := "nsxt_dne_section.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccTestMP(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXDneSectionCheckDestroy(state, sectionName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXDneSectionCreateTemplate(sectionName, "rule1"),
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"insert_before"},
+			},
+		},
+	})
+}
+
+func testAccNSXDneSectionExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		nsxClient := testAccProvider.Meta().(nsxtClients).NsxtClient
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("DNE Section resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("DNE Section resource ID not set in resources ")
+		}
+
+		section, responseCode, err := nsxClient.ServicesApi.GetDneSection(nsxClient.Context, resourceID)
+		if err != nil {
+			return fmt.Errorf("Error while retrieving DNE Section ID %s. Error: %v", resourceID, err)
+		}
+
+		if responseCode.StatusCode != http.StatusOK {
+			return fmt.Errorf("Error while checking if DNE Section %s exists. HTTP return code was %d", resourceID, responseCode.StatusCode)
+		}
+
+		if displayName == section.DisplayName {
+			return nil
+		}
+		return fmt.Errorf("DNE Section %s wasn't found", displayName)
+	}
+}
+
+func testAccNSXDneSectionCheckDestroy(state *terraform.State, displayName string) error {
+	nsxClient := testAccProvider.Meta().(nsxtClients).NsxtClient
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_dne_section" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		section, responseCode, err := nsxClient.ServicesApi.GetDneSection(nsxClient.Context, resourceID)
+		if err != nil {
+			if responseCode.StatusCode != http.StatusOK {
+				return nil
+			}
+			return fmt.Errorf("Error while retrieving DNE Section ID %s. Error: %v", resourceID, err)
+		}
+
+		if displayName == section.DisplayName {
+			return fmt.Errorf("DNE Section %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNSXDneSectionPrerequisites() string {
+	return `
+resource "nsxt_ns_group" "grp1" {
+  display_name = "grp1"
+}
+
+resource "nsxt_ns_group" "grp2" {
+  display_name = "grp2"
+}
+
+resource "nsxt_ip_protocol_ns_service" "test" {
+  protocol = "6"
+}
+
+resource "nsxt_dne_key_policy" "test" {
+  encrypt_algorithm = "AES_GCM_128"
+  encrypt_type      = "ENCRYPT_AND_INTEGRITY"
+  mac_algorithm     = "GMAC_128"
+}`
+}
+
+func testAccNSXDneSectionCreateTemplate(name string, ruleName string) string {
+	return testAccNSXDneSectionPrerequisites() + fmt.Sprintf(`
+resource "nsxt_dne_section" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test"
+
+  rule {
+    display_name  = "%s"
+    description   = "rule1"
+    key_policy_id = nsxt_dne_key_policy.test.id
+    logged        = true
+    ip_protocol   = "IPV4_IPV6"
+    comments      = "test rule"
+    rule_tag      = "test rule tag"
+
+    source {
+      target_id   = nsxt_ns_group.grp1.id
+      target_type = "NSGroup"
+    }
+
+    destination {
+      target_id   = nsxt_ns_group.grp2.id
+      target_type = "NSGroup"
+    }
+
+    service {
+      target_id   = nsxt_ip_protocol_ns_service.test.id
+      target_type = "NSService"
+    }
+  }
+}`, name, ruleName)
+}
+
+func testAccNSXDneSectionUpdateTemplate(name string, updatedRuleName string) string {
+	return testAccNSXDneSectionPrerequisites() + fmt.Sprintf(`
+resource "nsxt_dne_section" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test Update"
+
+  rule {
+    display_name  = "rule2"
+    key_policy_id = nsxt_dne_key_policy.test.id
+    ip_protocol   = "IPV6"
+  }
+
+  rule {
+    display_name  = "%s"
+    description   = "rule1"
+    key_policy_id = nsxt_dne_key_policy.test.id
+    ip_protocol   = "IPV4"
+    disabled      = true
+  }
+}`, name, updatedRuleName)
+}
+
+func testAccNSXDneSectionCreateEmptyTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_dne_section" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test"
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name)
+}
+
+func testAccNSXDneSectionUpdateEmptyTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_dne_section" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test Update"
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+
+  tag {
+    scope = "scope2"
+    tag   = "tag2"
+  }
+}`, name)
+}
+
+func testAccNSXDneSectionOrderedTemplate(names [3]string) string {
+	return fmt.Sprintf(`
+resource "nsxt_dne_section" "test1" {
+  display_name = "%s"
+}
+
+resource "nsxt_dne_section" "test2" {
+  display_name  = "%s"
+  insert_before = nsxt_dne_section.test1.id
+}
+
+resource "nsxt_dne_section" "test3" {
+  display_name  = "%s"
+  insert_before = nsxt_dne_section.test2.id
+}`, names[0], names[1], names[2])
+}
@@ -164,7 +164,7 @@ func setInsertParamsInSchema(d *schema.ResourceData, cookieDomain string, cookie
 	insertConfigList = append(insertConfigList, elem)
 	err := d.Set("insert_mode_params", insertConfigList)
 	if err != nil {
-		log.Printf("[WARNING]: Failed to set insert_mode_params in schema: %v", err)
+		addResourceWarning(d, "Failed to set insert_mode_params in schema", err.Error())
 	}
 }
 
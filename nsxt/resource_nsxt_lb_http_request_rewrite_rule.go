@@ -237,50 +237,50 @@ func setLbRuleHTTPRequestConditionsInSchema(d *schema.ResourceData, conditions [
 		}
 
 		// TODO: optimize this code with map of conditions and a loop
-		warningString := "[WARNING]: Failed to set %s in schema: %v"
+		warningString := "Failed to set %s in schema"
 		err := d.Set("header_condition", headerConditionList)
 		if err != nil {
-			log.Printf(warningString, "header_condition", err)
+			addResourceWarning(d, fmt.Sprintf(warningString, "header_condition"), err.Error())
 		}
 
 		err = d.Set("cookie_condition", cookieConditionList)
 		if err != nil {
-			log.Printf(warningString, "cookie_condition", err)
+			addResourceWarning(d, fmt.Sprintf(warningString, "cookie_condition"), err.Error())
 		}
 
 		err = d.Set("body_condition", bodyConditionList)
 		if err != nil {
-			log.Printf(warningString, "body_condition", err)
+			addResourceWarning(d, fmt.Sprintf(warningString, "body_condition"), err.Error())
 		}
 
 		err = d.Set("method_condition", methodConditionList)
 		if err != nil {
-			log.Printf(warningString, "method_condition", err)
+			addResourceWarning(d, fmt.Sprintf(warningString, "method_condition"), err.Error())
 		}
 
 		err = d.Set("version_condition", versionConditionList)
 		if err != nil {
-			log.Printf(warningString, "version_condition", err)
+			addResourceWarning(d, fmt.Sprintf(warningString, "version_condition"), err.Error())
 		}
 
 		err = d.Set("uri_condition", uriConditionList)
 		if err != nil {
-			log.Printf(warningString, "uri_condition", err)
+			addResourceWarning(d, fmt.Sprintf(warningString, "uri_condition"), err.Error())
 		}
 
 		err = d.Set("uri_arguments_condition", uriArgumentsConditionList)
 		if err != nil {
-			log.Printf(warningString, "uri_arguments_condition", err)
+			addResourceWarning(d, fmt.Sprintf(warningString, "uri_arguments_condition"), err.Error())
 		}
 
 		err = d.Set("ip_condition", ipConditionList)
 		if err != nil {
-			log.Printf(warningString, "ip_condition", err)
+			addResourceWarning(d, fmt.Sprintf(warningString, "ip_condition"), err.Error())
 		}
 
 		err = d.Set("tcp_condition", tcpConditionList)
 		if err != nil {
-			log.Printf(warningString, "tcp_condition", err)
+			addResourceWarning(d, fmt.Sprintf(warningString, "tcp_condition"), err.Error())
 		}
 
 	}
@@ -225,7 +225,7 @@ func setClientSSLBindingInSchema(d *schema.ResourceData, binding *loadbalancer.C
 
 	err := d.Set("client_ssl", bindingList)
 	if err != nil {
-		log.Printf("[WARNING]: Failed to set client SSL in schema: %v", err)
+		addResourceWarning(d, "Failed to set client SSL in schema", err.Error())
 	}
 }
 
@@ -265,7 +265,7 @@ func setServerSSLBindingInSchema(d *schema.ResourceData, binding *loadbalancer.S
 
 	err := d.Set("server_ssl", bindingList)
 	if err != nil {
-		log.Printf("[WARNING]: Failed to set server SSL in schema: %v", err)
+		addResourceWarning(d, "Failed to set server SSL in schema", err.Error())
 	}
 }
 
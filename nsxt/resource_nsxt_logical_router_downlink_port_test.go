@@ -69,7 +69,7 @@ func TestAccResourceNsxtLogicalRouterDownlinkPort_withRelay(t *testing.T) {
 	resourceType := "DhcpRelayService"
 	// this is needed to init the version
 	testAccNSXVersion(t, "2.2.0")
-	if nsxVersionLower("2.5.0") {
+	if versionLower(testAccNsxVersion, "2.5.0") {
 		resourceType = "LogicalService"
 	}
 
@@ -147,7 +147,7 @@ func resourceNsxtLogicalSwitchCreate(d *schema.ResourceData, m interface{}) erro
 	return resourceNsxtLogicalSwitchRead(d, m)
 }
 
-func resourceNsxtLogicalSwitchVerifyRealization(d *schema.ResourceData, nsxClient *api.APIClient, logicalSwitch *manager.LogicalSwitch, toleratePartialSuccess bool) error {
+func resourceNsxtLogicalSwitchVerifyRealization(d *schema.ResourceData, m interface{}, nsxClient *api.APIClient, logicalSwitch *manager.LogicalSwitch, toleratePartialSuccess bool) error {
 	// verifying switch realization on hypervisor
 	pendingStates := []string{"in_progress", "pending"}
 	targetStates := []string{"success"}
@@ -180,7 +180,7 @@ func resourceNsxtLogicalSwitchVerifyRealization(d *schema.ResourceData, nsxClien
 		MinTimeout: 1 * time.Second,
 		Delay:      1 * time.Second,
 	}
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(getProviderContext(m))
 	if err != nil {
 		// Realization failed - rollback & delete the switch
 		log.Printf("[ERROR] Rollback switch %s creation due to unrealized state", logicalSwitch.Id)
@@ -218,7 +218,7 @@ func resourceNsxtLogicalSwitchRead(d *schema.ResourceData, m interface{}) error
 	}
 
 	toleratePartialSuccess := getCommonProviderConfig(m.(nsxtClients)).ToleratePartialSuccess
-	err = resourceNsxtLogicalSwitchVerifyRealization(d, nsxClient, &logicalSwitch, toleratePartialSuccess)
+	err = resourceNsxtLogicalSwitchVerifyRealization(d, m, nsxClient, &logicalSwitch, toleratePartialSuccess)
 
 	if err != nil {
 		return err
@@ -125,7 +125,7 @@ func resourceNsxtNatRuleCreate(d *schema.ResourceData, m interface{}) error {
 	displayName := d.Get("display_name").(string)
 	tags := getTagsFromSchema(d)
 	action := d.Get("action").(string)
-	if action == "NO_NAT" && nsxVersionHigherOrEqual("3.0.0") {
+	if action == "NO_NAT" && nsxVersionHigherOrEqual(m, "3.0.0") {
 		return fmt.Errorf("NO_NAT action is not supported in NSX versions 3.0.0 and greater. Use NO_SNAT and NO_DNAT instead")
 	}
 	enabled := d.Get("enabled").(bool)
@@ -236,7 +236,7 @@ func resourceNsxtNatRuleUpdate(d *schema.ResourceData, m interface{}) error {
 	displayName := d.Get("display_name").(string)
 	tags := getTagsFromSchema(d)
 	action := d.Get("action").(string)
-	if action == "NO_NAT" && nsxVersionHigherOrEqual("3.0.0") {
+	if action == "NO_NAT" && nsxVersionHigherOrEqual(m, "3.0.0") {
 		return fmt.Errorf("NO_NAT action is not supported in NSX versions 3.0.0 and greater. Use NO_SNAT and NO_DNAT instead")
 	}
 	enabled := d.Get("enabled").(bool)
@@ -211,7 +211,7 @@ func resourceNsxtPolicyBgpNeighborExists(t0ID string, localeServiceID string, ne
 	return false, logAPIError("Error retrieving resource", err)
 }
 
-func resourceNsxtPolicyBgpNeighborResourceDataToStruct(d *schema.ResourceData, id string) (model.BgpNeighborConfig, error) {
+func resourceNsxtPolicyBgpNeighborResourceDataToStruct(d *schema.ResourceData, m interface{}, id string) (model.BgpNeighborConfig, error) {
 	var neighborStruct model.BgpNeighborConfig
 
 	displayName := d.Get("display_name").(string)
@@ -243,13 +243,13 @@ func resourceNsxtPolicyBgpNeighborResourceDataToStruct(d *schema.ResourceData, i
 
 	var rFilters []model.BgpRouteFiltering
 	routeFiltering := d.Get("route_filtering").([]interface{})
-	if len(routeFiltering) > 1 && nsxVersionLower("3.0.0") {
+	if len(routeFiltering) > 1 && nsxVersionLower(m, "3.0.0") {
 		return neighborStruct, fmt.Errorf("Only 1 element for 'route_filtering' is supported with NSX-T versions up to 3.0.0")
 	}
 	for _, filter := range routeFiltering {
 		data := filter.(map[string]interface{})
 		addrFamily := data["address_family"].(string)
-		if addrFamily == model.BgpRouteFiltering_ADDRESS_FAMILY_L2VPN_EVPN && nsxVersionLower("3.0.0") {
+		if addrFamily == model.BgpRouteFiltering_ADDRESS_FAMILY_L2VPN_EVPN && nsxVersionLower(m, "3.0.0") {
 			return neighborStruct, fmt.Errorf("'%s' is not supported for 'address_family' with NSX-T versions less than 3.0.0", model.BgpRouteFiltering_ADDRESS_FAMILY_L2VPN_EVPN)
 		}
 		enabled := data["enabled"].(bool)
@@ -271,7 +271,7 @@ func resourceNsxtPolicyBgpNeighborResourceDataToStruct(d *schema.ResourceData, i
 			filterStruct.OutRouteFilters = outFilters
 		}
 
-		if nsxVersionHigherOrEqual("3.0.0") && data["maximum_routes"] != 0 {
+		if nsxVersionHigherOrEqual(m, "3.0.0") && data["maximum_routes"] != 0 {
 			maxRoutes := int64(data["maximum_routes"].(int))
 			filterStruct.MaximumRoutes = &maxRoutes
 		}
@@ -307,7 +307,7 @@ func resourceNsxtPolicyBgpNeighborConvertAndPatch(id string, d *schema.ResourceD
 		return fmt.Errorf("Invalid bgp_path %s", bgpPath)
 	}
 
-	obj, err := resourceNsxtPolicyBgpNeighborResourceDataToStruct(d, id)
+	obj, err := resourceNsxtPolicyBgpNeighborResourceDataToStruct(d, m, id)
 	if err != nil {
 		return err
 	}
@@ -445,7 +445,7 @@ func resourceNsxtPolicyBgpNeighborRead(d *schema.ResourceData, m interface{}) er
 		}
 		rf["in_route_filter"] = inFilter
 		rf["out_route_filter"] = outFilter
-		if nsxVersionHigherOrEqual("3.0.0") && filter.MaximumRoutes != nil {
+		if nsxVersionHigherOrEqual(m, "3.0.0") && filter.MaximumRoutes != nil {
 			rf["maximum_routes"] = int(*filter.MaximumRoutes)
 		}
 		rFilters = append(rFilters, rf)
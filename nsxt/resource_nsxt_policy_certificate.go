@@ -0,0 +1,252 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var policyCertificateKeyAlgoValues = []string{"RSA", "DSA", "ECDSA"}
+
+func resourceNsxtPolicyCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyCertificateCreate,
+		Read:   resourceNsxtPolicyCertificateRead,
+		Update: resourceNsxtPolicyCertificateUpdate,
+		Delete: resourceNsxtPolicyCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"pem_encoded": {
+				Type:             schema.TypeString,
+				Description:      "PEM encoded certificate or certificate chain",
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: pemDiffSuppress,
+			},
+			"private_key": {
+				Type:        schema.TypeString,
+				Description: "PEM encoded private key corresponding to the leaf certificate",
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+			},
+			"passphrase": {
+				Type:        schema.TypeString,
+				Description: "Password for private key encryption",
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+			},
+			"key_algo": {
+				Type:         schema.TypeString,
+				Description:  "Key algorithm contained in this certificate",
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(policyCertificateKeyAlgoValues, false),
+			},
+			"expiry": {
+				Type:        schema.TypeString,
+				Description: "Expiration time of the leaf certificate in RFC3339 format",
+				Computed:    true,
+			},
+			"fingerprint": {
+				Type:        schema.TypeString,
+				Description: "SHA-256 fingerprint of the leaf certificate",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyCertificateExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	var err error
+	if isGlobalManager {
+		client := gm_infra.NewDefaultCertificatesClient(connector)
+		_, err = client.Get(id, nil)
+	} else {
+		client := infra.NewDefaultCertificatesClient(connector)
+		_, err = client.Get(id, nil)
+	}
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving Certificate", err)
+}
+
+func policyCertificatePatch(id string, d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	pemEncoded := d.Get("pem_encoded").(string)
+	privateKey := d.Get("private_key").(string)
+	passphrase := d.Get("passphrase").(string)
+	keyAlgo := d.Get("key_algo").(string)
+
+	obj := model.TlsTrustData{
+		DisplayName: &displayName,
+		Description: &description,
+		Tags:        tags,
+		PemEncoded:  &pemEncoded,
+	}
+
+	if len(privateKey) > 0 {
+		obj.PrivateKey = &privateKey
+	}
+	if len(passphrase) > 0 {
+		obj.Passphrase = &passphrase
+	}
+	if len(keyAlgo) > 0 {
+		obj.KeyAlgo = &keyAlgo
+	}
+
+	if isPolicyGlobalManager(m) {
+		gmObj, err := convertModelBindingType(obj, model.TlsTrustDataBindingType(), gm_model.TlsTrustDataBindingType())
+		if err != nil {
+			return err
+		}
+		client := gm_infra.NewDefaultCertificatesClient(connector)
+		return client.Patch(id, gmObj.(gm_model.TlsTrustData))
+	}
+
+	client := infra.NewDefaultCertificatesClient(connector)
+	return client.Patch(id, obj)
+}
+
+func resourceNsxtPolicyCertificateCreate(d *schema.ResourceData, m interface{}) error {
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyCertificateExists)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Importing Certificate with ID %s", id)
+	err = policyCertificatePatch(id, d, m)
+	if err != nil {
+		return handleCreateError("Certificate", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyCertificateRead(d, m)
+}
+
+func resourceNsxtPolicyCertificateRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Certificate ID")
+	}
+
+	details := true
+	var obj model.TlsCertificate
+	if isPolicyGlobalManager(m) {
+		client := gm_infra.NewDefaultCertificatesClient(connector)
+		gmObj, err := client.Get(id, &details)
+		if err != nil {
+			return handleReadError(d, "Certificate", id, err)
+		}
+		rawObj, err := convertModelBindingType(gmObj, gm_model.TlsCertificateBindingType(), model.TlsCertificateBindingType())
+		if err != nil {
+			return err
+		}
+		obj = rawObj.(model.TlsCertificate)
+	} else {
+		var err error
+		client := infra.NewDefaultCertificatesClient(connector)
+		obj, err = client.Get(id, &details)
+		if err != nil {
+			return handleReadError(d, "Certificate", id, err)
+		}
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	if obj.PemEncoded != nil {
+		d.Set("pem_encoded", obj.PemEncoded)
+		d.Set("fingerprint", getPemFingerprint(*obj.PemEncoded))
+	}
+
+	// First entry in details describes the leaf certificate
+	if len(obj.Details) > 0 {
+		leaf := obj.Details[0]
+		d.Set("expiry", epochMillisToRFC3339(leaf.NotAfter))
+		if leaf.PublicKeyAlgo != nil {
+			d.Set("key_algo", leaf.PublicKeyAlgo)
+		}
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyCertificateUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Certificate ID")
+	}
+
+	// Only metadata can change here, certificate data changes force replacement
+	log.Printf("[INFO] Updating Certificate with ID %s", id)
+	err := policyCertificatePatch(id, d, m)
+	if err != nil {
+		return handleUpdateError("Certificate", id, err)
+	}
+
+	return resourceNsxtPolicyCertificateRead(d, m)
+}
+
+func resourceNsxtPolicyCertificateDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Certificate ID")
+	}
+
+	var err error
+	connector := getPolicyConnector(m)
+	if isPolicyGlobalManager(m) {
+		client := gm_infra.NewDefaultCertificatesClient(connector)
+		err = client.Delete(id)
+	} else {
+		client := infra.NewDefaultCertificatesClient(connector)
+		err = client.Delete(id)
+	}
+
+	if err != nil {
+		return handleDeleteError("Certificate", id, err)
+	}
+
+	return nil
+}
@@ -50,6 +50,7 @@ func resourceNsxtPolicyContextProfile() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: nsxVersionCustomizeDiff("3.0.0"),
 
 		Schema: map[string]*schema.Schema{
 			"nsx_id":       getNsxIDSchema(),
@@ -0,0 +1,214 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyCrl() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyCrlCreate,
+		Read:   resourceNsxtPolicyCrlRead,
+		Update: resourceNsxtPolicyCrlUpdate,
+		Delete: resourceNsxtPolicyCrlDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"pem_encoded": {
+				Type:             schema.TypeString,
+				Description:      "PEM encoded certificate revocation list",
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: pemDiffSuppress,
+			},
+			"issuer": {
+				Type:        schema.TypeString,
+				Description: "Issuer of this CRL",
+				Computed:    true,
+			},
+			"expiry": {
+				Type:        schema.TypeString,
+				Description: "Time of next CRL update as reported by NSX",
+				Computed:    true,
+			},
+			"fingerprint": {
+				Type:        schema.TypeString,
+				Description: "SHA-256 fingerprint of the CRL",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyCrlExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	var err error
+	if isGlobalManager {
+		client := gm_infra.NewDefaultCrlsClient(connector)
+		_, err = client.Get(id, nil)
+	} else {
+		client := infra.NewDefaultCrlsClient(connector)
+		_, err = client.Get(id, nil)
+	}
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving CRL", err)
+}
+
+func policyCrlPatch(id string, d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	pemEncoded := d.Get("pem_encoded").(string)
+
+	obj := model.TlsCrl{
+		DisplayName: &displayName,
+		Description: &description,
+		Tags:        tags,
+		PemEncoded:  &pemEncoded,
+	}
+
+	if isPolicyGlobalManager(m) {
+		gmObj, err := convertModelBindingType(obj, model.TlsCrlBindingType(), gm_model.TlsCrlBindingType())
+		if err != nil {
+			return err
+		}
+		client := gm_infra.NewDefaultCrlsClient(connector)
+		return client.Patch(id, gmObj.(gm_model.TlsCrl))
+	}
+
+	client := infra.NewDefaultCrlsClient(connector)
+	return client.Patch(id, obj)
+}
+
+func resourceNsxtPolicyCrlCreate(d *schema.ResourceData, m interface{}) error {
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyCrlExists)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Importing CRL with ID %s", id)
+	err = policyCrlPatch(id, d, m)
+	if err != nil {
+		return handleCreateError("CRL", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyCrlRead(d, m)
+}
+
+func resourceNsxtPolicyCrlRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining CRL ID")
+	}
+
+	details := true
+	var obj model.TlsCrl
+	if isPolicyGlobalManager(m) {
+		client := gm_infra.NewDefaultCrlsClient(connector)
+		gmObj, err := client.Get(id, &details)
+		if err != nil {
+			return handleReadError(d, "CRL", id, err)
+		}
+		rawObj, err := convertModelBindingType(gmObj, gm_model.TlsCrlBindingType(), model.TlsCrlBindingType())
+		if err != nil {
+			return err
+		}
+		obj = rawObj.(model.TlsCrl)
+	} else {
+		var err error
+		client := infra.NewDefaultCrlsClient(connector)
+		obj, err = client.Get(id, &details)
+		if err != nil {
+			return handleReadError(d, "CRL", id, err)
+		}
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	if obj.PemEncoded != nil {
+		d.Set("pem_encoded", obj.PemEncoded)
+		d.Set("fingerprint", getPemFingerprint(*obj.PemEncoded))
+	}
+
+	if obj.Details != nil {
+		d.Set("issuer", obj.Details.Issuer)
+		d.Set("expiry", obj.Details.NextUpdate)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyCrlUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining CRL ID")
+	}
+
+	log.Printf("[INFO] Updating CRL with ID %s", id)
+	err := policyCrlPatch(id, d, m)
+	if err != nil {
+		return handleUpdateError("CRL", id, err)
+	}
+
+	return resourceNsxtPolicyCrlRead(d, m)
+}
+
+func resourceNsxtPolicyCrlDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining CRL ID")
+	}
+
+	var err error
+	connector := getPolicyConnector(m)
+	if isPolicyGlobalManager(m) {
+		client := gm_infra.NewDefaultCrlsClient(connector)
+		err = client.Delete(id)
+	} else {
+		client := infra.NewDefaultCrlsClient(connector)
+		err = client.Delete(id)
+	}
+
+	if err != nil {
+		return handleDeleteError("CRL", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const testAccPolicyCrlPem = `-----BEGIN X509 CRL-----
+MIIBdzBhAgEBMA0GCSqGSIb3DQEBCwUAMB8xHTAbBgNVBAMMFHRlcnJhZm9ybS1h
+Y2N0ZXN0LWNhFw0yNjEwMTgyMTQ0NDJaFw0zNjEwMTUyMTQ0NDJaoA4wDDAKBgNV
+HRQEAwIBATANBgkqhkiG9w0BAQsFAAOCAQEAhaDPD/fGsP342wezNf58tie1APAm
+49rBT4GwzrX1cAdg4pBI99s/U7zwi4+58R6DMDrD9N69ba6vvFjoPY6pIMSbRyEF
+vKkeAvjfMSAg2nc5n/jUZFRzlizHoxiZMUn7RoeiggU6oo53Bietr2jhOvu9VcOI
+P7ImuMC/T+5c06IqCNRuSZ6FI54mKR8mBNIFSqR84pNYgjnB+r3qj8cIZE9uSWpC
+Oi+tGOzAE3+1sa4lABildODAk84I7+CdBsP0niC7ZwxAPjfMEdjfKt69aTvb9341
+zG1aoapC9zh6FgIrZ9TQPauw2mDnP8hVfIJ9DKyYB+rwyxEH34D7I4wFeg==
+-----END X509 CRL-----`
+
+var accTestPolicyCrlCreateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform created",
+}
+
+var accTestPolicyCrlUpdateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform updated",
+}
+
+func TestAccResourceNsxtPolicyCrl_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_crl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyCrlCheckDestroy(state, accTestPolicyCrlUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyCrlTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyCrlExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyCrlCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyCrlCreateAttributes["description"]),
+					resource.TestCheckResourceAttrSet(testResourceName, "issuer"),
+					resource.TestCheckResourceAttrSet(testResourceName, "expiry"),
+					resource.TestCheckResourceAttrSet(testResourceName, "fingerprint"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyCrlTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyCrlExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyCrlUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyCrlUpdateAttributes["description"]),
+					resource.TestCheckResourceAttrSet(testResourceName, "fingerprint"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyCrl_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_crl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyCrlCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyCrlMinimalistic(name),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyCrlCheckDestroy(state *terraform.State, displayName string) error {
+	return testAccNsxtPolicyResourceCheckDestroy(state, displayName, "nsxt_policy_crl", resourceNsxtPolicyCrlExists)
+}
+
+func testAccNsxtPolicyCrlTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyCrlCreateAttributes
+	} else {
+		attrMap = accTestPolicyCrlUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_crl" "test" {
+  display_name = "%s"
+  description  = "%s"
+  pem_encoded  = <<EOT
+%s
+EOT
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], testAccPolicyCrlPem)
+}
+
+func testAccNsxtPolicyCrlMinimalistic(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_crl" "test" {
+  display_name = "%s"
+  pem_encoded  = <<EOT
+%s
+EOT
+}`, name, testAccPolicyCrlPem)
+}
@@ -9,6 +9,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 )
@@ -43,9 +45,14 @@ func resourceNsxtPolicyDhcpRelayConfig() *schema.Resource {
 }
 
 func resourceNsxtPolicyDhcpRelayConfigExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
-	client := infra.NewDefaultDhcpRelayConfigsClient(connector)
-
-	_, err := client.Get(id)
+	var err error
+	if isGlobalManager {
+		client := gm_infra.NewDefaultDhcpRelayConfigsClient(connector)
+		_, err = client.Get(id)
+	} else {
+		client := infra.NewDefaultDhcpRelayConfigsClient(connector)
+		_, err = client.Get(id)
+	}
 	if err == nil {
 		return true, nil
 	}
@@ -57,35 +64,45 @@ func resourceNsxtPolicyDhcpRelayConfigExists(id string, connector *client.RestCo
 	return false, logAPIError("Error retrieving resource", err)
 }
 
-func resourceNsxtPolicyDhcpRelayConfigCreate(d *schema.ResourceData, m interface{}) error {
-	connector := getPolicyConnector(m)
-	client := infra.NewDefaultDhcpRelayConfigsClient(connector)
-
-	if client == nil {
-		return policyResourceNotSupportedError()
-	}
-
-	// Initialize resource Id and verify this ID is not yet used
-	id, err := getOrGenerateID(d, m, resourceNsxtPolicyDhcpRelayConfigExists)
-	if err != nil {
-		return err
-	}
-
+func resourceNsxtPolicyDhcpRelayConfigSchemaToModel(d *schema.ResourceData) model.DhcpRelayConfig {
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
 	tags := getPolicyTagsFromSchema(d)
 	serverAddresses := getStringListFromSchemaList(d, "server_addresses")
 
-	obj := model.DhcpRelayConfig{
+	return model.DhcpRelayConfig{
 		DisplayName:     &displayName,
 		Description:     &description,
 		Tags:            tags,
 		ServerAddresses: serverAddresses,
 	}
+}
+
+func resourceNsxtPolicyDhcpRelayConfigCreate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyDhcpRelayConfigExists)
+	if err != nil {
+		return err
+	}
+
+	obj := resourceNsxtPolicyDhcpRelayConfigSchemaToModel(d)
 
 	// Create the resource using PATCH
 	log.Printf("[INFO] Creating DhcpRelayConfig with ID %s", id)
-	err = client.Patch(id, obj)
+	if isPolicyGlobalManager(m) {
+		gmObj, err1 := convertModelBindingType(obj, model.DhcpRelayConfigBindingType(), gm_model.DhcpRelayConfigBindingType())
+		if err1 != nil {
+			return err1
+		}
+
+		client := gm_infra.NewDefaultDhcpRelayConfigsClient(connector)
+		err = client.Patch(id, gmObj.(gm_model.DhcpRelayConfig))
+	} else {
+		client := infra.NewDefaultDhcpRelayConfigsClient(connector)
+		err = client.Patch(id, obj)
+	}
 	if err != nil {
 		return handleCreateError("DhcpRelayConfig", id, err)
 	}
@@ -98,20 +115,31 @@ func resourceNsxtPolicyDhcpRelayConfigCreate(d *schema.ResourceData, m interface
 
 func resourceNsxtPolicyDhcpRelayConfigRead(d *schema.ResourceData, m interface{}) error {
 	connector := getPolicyConnector(m)
-	client := infra.NewDefaultDhcpRelayConfigsClient(connector)
-
-	if client == nil {
-		return policyResourceNotSupportedError()
-	}
 
 	id := d.Id()
 	if id == "" {
 		return fmt.Errorf("Error obtaining DhcpRelayConfig ID")
 	}
 
-	obj, err := client.Get(id)
-	if err != nil {
-		return handleReadError(d, "DhcpRelayConfig", id, err)
+	var obj model.DhcpRelayConfig
+	if isPolicyGlobalManager(m) {
+		client := gm_infra.NewDefaultDhcpRelayConfigsClient(connector)
+		gmObj, err := client.Get(id)
+		if err != nil {
+			return handleReadError(d, "DhcpRelayConfig", id, err)
+		}
+		rawObj, err := convertModelBindingType(gmObj, gm_model.DhcpRelayConfigBindingType(), model.DhcpRelayConfigBindingType())
+		if err != nil {
+			return err
+		}
+		obj = rawObj.(model.DhcpRelayConfig)
+	} else {
+		var err error
+		client := infra.NewDefaultDhcpRelayConfigsClient(connector)
+		obj, err = client.Get(id)
+		if err != nil {
+			return handleReadError(d, "DhcpRelayConfig", id, err)
+		}
 	}
 
 	d.Set("display_name", obj.DisplayName)
@@ -128,33 +156,29 @@ func resourceNsxtPolicyDhcpRelayConfigRead(d *schema.ResourceData, m interface{}
 
 func resourceNsxtPolicyDhcpRelayConfigUpdate(d *schema.ResourceData, m interface{}) error {
 	connector := getPolicyConnector(m)
-	client := infra.NewDefaultDhcpRelayConfigsClient(connector)
-	if client == nil {
-		return policyResourceNotSupportedError()
-	}
 
 	id := d.Id()
 	if id == "" {
 		return fmt.Errorf("Error obtaining DhcpRelayConfig ID")
 	}
 
-	// Read the rest of the configured parameters
-	description := d.Get("description").(string)
-	displayName := d.Get("display_name").(string)
-	tags := getPolicyTagsFromSchema(d)
+	obj := resourceNsxtPolicyDhcpRelayConfigSchemaToModel(d)
 	revision := int64(d.Get("revision").(int))
+	obj.Revision = &revision
 
-	serverAddresses := getStringListFromSchemaList(d, "server_addresses")
+	var err error
+	if isPolicyGlobalManager(m) {
+		gmObj, err1 := convertModelBindingType(obj, model.DhcpRelayConfigBindingType(), gm_model.DhcpRelayConfigBindingType())
+		if err1 != nil {
+			return err1
+		}
 
-	obj := model.DhcpRelayConfig{
-		DisplayName:     &displayName,
-		Description:     &description,
-		Tags:            tags,
-		ServerAddresses: serverAddresses,
-		Revision:        &revision,
+		client := gm_infra.NewDefaultDhcpRelayConfigsClient(connector)
+		_, err = client.Update(id, gmObj.(gm_model.DhcpRelayConfig))
+	} else {
+		client := infra.NewDefaultDhcpRelayConfigsClient(connector)
+		_, err = client.Update(id, obj)
 	}
-
-	_, err := client.Update(id, obj)
 	if err != nil {
 		return handleUpdateError("DhcpRelayConfig", id, err)
 	}
@@ -168,13 +192,16 @@ func resourceNsxtPolicyDhcpRelayConfigDelete(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error obtaining DhcpRelayConfig ID")
 	}
 
+	var err error
 	connector := getPolicyConnector(m)
-	client := infra.NewDefaultDhcpRelayConfigsClient(connector)
-	if client == nil {
-		return policyResourceNotSupportedError()
+	if isPolicyGlobalManager(m) {
+		client := gm_infra.NewDefaultDhcpRelayConfigsClient(connector)
+		err = client.Delete(id)
+	} else {
+		client := infra.NewDefaultDhcpRelayConfigsClient(connector)
+		err = client.Delete(id)
 	}
 
-	err := client.Delete(id)
 	if err != nil {
 		return handleDeleteError("DhcpRelayConfig", id, err)
 	}
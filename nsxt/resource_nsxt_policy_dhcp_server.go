@@ -25,6 +25,7 @@ func resourceNsxtPolicyDhcpServer() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: nsxVersionCustomizeDiff("3.0.0"),
 
 		Schema: map[string]*schema.Schema{
 			"nsx_id":            getNsxIDSchema(),
@@ -198,10 +199,6 @@ func resourceNsxtPolicyDhcpServerRead(d *schema.ResourceData, m interface{}) err
 
 func resourceNsxtPolicyDhcpServerUpdate(d *schema.ResourceData, m interface{}) error {
 	connector := getPolicyConnector(m)
-	client := infra.NewDefaultDhcpServerConfigsClient(connector)
-	if client == nil {
-		return policyResourceNotSupportedError()
-	}
 
 	id := d.Id()
 	if id == "" {
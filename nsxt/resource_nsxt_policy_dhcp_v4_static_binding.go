@@ -29,6 +29,7 @@ func resourceNsxtPolicyDhcpV4StaticBinding() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: nsxtSegmentResourceImporter,
 		},
+		CustomizeDiff: nsxVersionCustomizeDiff("3.0.0"),
 
 		Schema: map[string]*schema.Schema{
 			"nsx_id":       getNsxIDSchema(),
@@ -27,6 +27,7 @@ func resourceNsxtPolicyDhcpV6StaticBinding() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: nsxtSegmentResourceImporter,
 		},
+		CustomizeDiff: nsxVersionCustomizeDiff("3.0.0"),
 
 		Schema: map[string]*schema.Schema{
 			"nsx_id":       getNsxIDSchema(),
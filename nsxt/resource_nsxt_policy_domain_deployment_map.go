@@ -0,0 +1,169 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_domains "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/domains"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+)
+
+func resourceNsxtPolicyDomainDeploymentMap() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyDomainDeploymentMapCreate,
+		Read:   resourceNsxtPolicyDomainDeploymentMapRead,
+		Update: resourceNsxtPolicyDomainDeploymentMapUpdate,
+		Delete: resourceNsxtPolicyDomainDeploymentMapDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtDomainResourceImporter,
+		},
+		CustomizeDiff: nsxVersionCustomizeDiff("3.1.0"),
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":                 getNsxIDSchema(),
+			"path":                   getPathSchema(),
+			"display_name":           getDisplayNameSchema(),
+			"description":            getDescriptionSchema(),
+			"revision":               getRevisionSchema(),
+			"tag":                    getTagsSchema(),
+			"domain":                 getDomainNameSchema(),
+			"enforcement_point_path": getPolicyPathSchema(true, false, "Path of the site enforcement point to span the domain to"),
+		},
+	}
+}
+
+func resourceNsxtPolicyDomainDeploymentMapExistsInDomain(id string, domain string, connector *client.RestConnector) (bool, error) {
+	client := gm_domains.NewDefaultDomainDeploymentMapsClient(connector)
+	_, err := client.Get(domain, id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving DomainDeploymentMap", err)
+}
+
+func resourceNsxtPolicyDomainDeploymentMapExistsInDomainPartial(domain string) func(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	return func(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+		return resourceNsxtPolicyDomainDeploymentMapExistsInDomain(id, domain, connector)
+	}
+}
+
+func policyDomainDeploymentMapPatch(id string, d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	client := gm_domains.NewDefaultDomainDeploymentMapsClient(connector)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyGlobalManagerTagsFromSchema(d)
+	enforcementPointPath := d.Get("enforcement_point_path").(string)
+
+	obj := gm_model.DomainDeploymentMap{
+		DisplayName:          &displayName,
+		Description:          &description,
+		Tags:                 tags,
+		EnforcementPointPath: &enforcementPointPath,
+	}
+
+	return client.Patch(d.Get("domain").(string), id, obj)
+}
+
+func resourceNsxtPolicyDomainDeploymentMapCreate(d *schema.ResourceData, m interface{}) error {
+	if !isPolicyGlobalManager(m) {
+		return globalManagerOnlyError()
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyDomainDeploymentMapExistsInDomainPartial(d.Get("domain").(string)))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating DomainDeploymentMap with ID %s", id)
+	err = policyDomainDeploymentMapPatch(id, d, m)
+	if err != nil {
+		return handleCreateError("DomainDeploymentMap", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyDomainDeploymentMapRead(d, m)
+}
+
+func resourceNsxtPolicyDomainDeploymentMapRead(d *schema.ResourceData, m interface{}) error {
+	if !isPolicyGlobalManager(m) {
+		return globalManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	client := gm_domains.NewDefaultDomainDeploymentMapsClient(connector)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining DomainDeploymentMap ID")
+	}
+
+	obj, err := client.Get(d.Get("domain").(string), id)
+	if err != nil {
+		return handleReadError(d, "DomainDeploymentMap", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyGlobalManagerTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("enforcement_point_path", obj.EnforcementPointPath)
+
+	return nil
+}
+
+func resourceNsxtPolicyDomainDeploymentMapUpdate(d *schema.ResourceData, m interface{}) error {
+	if !isPolicyGlobalManager(m) {
+		return globalManagerOnlyError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining DomainDeploymentMap ID")
+	}
+
+	log.Printf("[INFO] Updating DomainDeploymentMap with ID %s", id)
+	err := policyDomainDeploymentMapPatch(id, d, m)
+	if err != nil {
+		return handleUpdateError("DomainDeploymentMap", id, err)
+	}
+
+	return resourceNsxtPolicyDomainDeploymentMapRead(d, m)
+}
+
+func resourceNsxtPolicyDomainDeploymentMapDelete(d *schema.ResourceData, m interface{}) error {
+	if !isPolicyGlobalManager(m) {
+		return globalManagerOnlyError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining DomainDeploymentMap ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := gm_domains.NewDefaultDomainDeploymentMapsClient(connector)
+	err := client.Delete(d.Get("domain").(string), id)
+	if err != nil {
+		return handleDeleteError("DomainDeploymentMap", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,200 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyDomainDeploymentMapCreateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform created",
+}
+
+var accTestPolicyDomainDeploymentMapUpdateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform updated",
+}
+
+func testAccPolicyDomainDeploymentMapPreCheck(t *testing.T) {
+	testAccOnlyGlobalManager(t)
+	testAccEnvDefined(t, "NSXT_TEST_SITE_NAME")
+	testAccEnvDefined(t, "NSXT_TEST_ANOTHER_SITE_NAME")
+	testAccPreCheck(t)
+}
+
+func TestAccResourceNsxtPolicyDomainDeploymentMap_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_domain_deployment_map.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPolicyDomainDeploymentMapPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyDomainDeploymentMapCheckDestroy(state, accTestPolicyDomainDeploymentMapUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyDomainDeploymentMapTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyDomainDeploymentMapExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyDomainDeploymentMapCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyDomainDeploymentMapCreateAttributes["description"]),
+					resource.TestCheckResourceAttrSet(testResourceName, "enforcement_point_path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "domain"),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyDomainDeploymentMapTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyDomainDeploymentMapExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyDomainDeploymentMapUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyDomainDeploymentMapUpdateAttributes["description"]),
+					resource.TestCheckResourceAttrSet(testResourceName, "enforcement_point_path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "domain"),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyDomainDeploymentMap_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_domain_deployment_map.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPolicyDomainDeploymentMapPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyDomainDeploymentMapCheckDestroy(state, accTestPolicyDomainDeploymentMapUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyDomainDeploymentMapTemplate(false),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccNsxtPolicyDomainDeploymentMapImporterGetID,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyDomainDeploymentMapImporterGetID(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources["nsxt_policy_domain_deployment_map.test"]
+	if !ok {
+		return "", fmt.Errorf("Policy DomainDeploymentMap resource not found in resources")
+	}
+	resourceID := rs.Primary.ID
+	if resourceID == "" {
+		return "", fmt.Errorf("Policy DomainDeploymentMap resource ID not set in resources")
+	}
+	domain := rs.Primary.Attributes["domain"]
+	if domain == "" {
+		return "", fmt.Errorf("Policy DomainDeploymentMap domain not set in resources")
+	}
+	return fmt.Sprintf("%s/%s", domain, resourceID), nil
+}
+
+func testAccNsxtPolicyDomainDeploymentMapExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy DomainDeploymentMap resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy DomainDeploymentMap resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyDomainDeploymentMapExistsInDomain(resourceID, rs.Primary.Attributes["domain"], connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy DomainDeploymentMap %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyDomainDeploymentMapCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_domain_deployment_map" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyDomainDeploymentMapExistsInDomain(resourceID, rs.Primary.Attributes["domain"], connector)
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy DomainDeploymentMap %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+// Domain is deployed to one site by nsxt_policy_domain, and spanned
+// to another site by the deployment map
+func testAccNsxtPolicyDomainDeploymentMapDeps() string {
+	return fmt.Sprintf(`
+data "nsxt_policy_site" "another" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_domain" "test" {
+  display_name = "%s"
+  sites        = ["%s"]
+
+  lifecycle {
+    ignore_changes = [sites]
+  }
+}`, getTestAnotherSiteName(), getAccTestResourceName(), getTestSiteName())
+}
+
+func testAccNsxtPolicyDomainDeploymentMapTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyDomainDeploymentMapCreateAttributes
+	} else {
+		attrMap = accTestPolicyDomainDeploymentMapUpdateAttributes
+	}
+	return testAccNsxtPolicyDomainDeploymentMapDeps() + fmt.Sprintf(`
+
+resource "nsxt_policy_domain_deployment_map" "test" {
+  display_name           = "%s"
+  description            = "%s"
+  domain                 = nsxt_policy_domain.test.nsx_id
+  enforcement_point_path = "${data.nsxt_policy_site.another.path}/enforcement-points/default"
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"])
+}
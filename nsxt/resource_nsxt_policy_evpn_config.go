@@ -28,6 +28,7 @@ func resourceNsxtPolicyEvpnConfig() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyEvpnConfigImport,
 		},
+		CustomizeDiff: nsxVersionCustomizeDiff("3.1.0"),
 
 		Schema: map[string]*schema.Schema{
 			"path":          getPathSchema(),
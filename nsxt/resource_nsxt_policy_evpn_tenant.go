@@ -22,6 +22,7 @@ func resourceNsxtPolicyEvpnTenant() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: nsxVersionCustomizeDiff("3.1.0"),
 
 		Schema: map[string]*schema.Schema{
 			"nsx_id":              getNsxIDSchema(),
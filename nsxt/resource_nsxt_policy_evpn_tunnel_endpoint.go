@@ -23,6 +23,7 @@ func resourceNsxtPolicyEvpnTunnelEndpoint() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyEvpnTunnelEndpointImport,
 		},
+		CustomizeDiff: nsxVersionCustomizeDiff("3.1.0"),
 
 		Schema: map[string]*schema.Schema{
 			"nsx_id":                  getNsxIDSchema(),
@@ -0,0 +1,333 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+const policyFirewallAutoDraftPrefix string = "terraform-auto-draft-"
+
+// Number of auto drafts kept, older ones are deleted
+const policyFirewallAutoDraftCount int = 5
+
+// Auto draft is captured once per provider run, before the first firewall
+// change, so that it reflects configuration prior to the apply
+type policyFirewallAutoDraft struct {
+	once sync.Once
+	err  error
+}
+
+func resourceNsxtPolicyFirewallDraft() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyFirewallDraftCreate,
+		Read:   resourceNsxtPolicyFirewallDraftRead,
+		Update: resourceNsxtPolicyFirewallDraftUpdate,
+		Delete: resourceNsxtPolicyFirewallDraftDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: nsxVersionCustomizeDiff("3.0.0"),
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"ref_draft_path": {
+				Type:         schema.TypeString,
+				Description:  "Path of the draft this draft is based on. If not specified, current configuration is captured",
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"locked": {
+				Type:        schema.TypeBool,
+				Description: "Lock the draft to prevent modifications by other users",
+				Optional:    true,
+				Default:     false,
+			},
+			"lock_comments": {
+				Type:        schema.TypeString,
+				Description: "Comments for locking or unlocking the draft",
+				Optional:    true,
+			},
+			"publish": {
+				Type:        schema.TypeBool,
+				Description: "Publish the draft, which reverts firewall configuration to the draft state",
+				Optional:    true,
+				Default:     false,
+			},
+			"is_auto_draft": {
+				Type:        schema.TypeBool,
+				Description: "Whether the draft was created by the system",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyFirewallDraftExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	client := infra.NewDefaultDraftsClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving PolicyDraft", err)
+}
+
+func policyFirewallDraftPatch(id string, d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	client := infra.NewDefaultDraftsClient(connector)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	locked := d.Get("locked").(bool)
+	lockComments := d.Get("lock_comments").(string)
+
+	obj := model.PolicyDraft{
+		DisplayName:  &displayName,
+		Description:  &description,
+		Tags:         tags,
+		Locked:       &locked,
+		LockComments: &lockComments,
+	}
+
+	refDraftPath := d.Get("ref_draft_path").(string)
+	if refDraftPath != "" {
+		obj.RefDraftPath = &refDraftPath
+	}
+
+	return client.Patch(id, obj)
+}
+
+func policyFirewallDraftPublish(id string, m interface{}) error {
+	connector := getPolicyConnector(m)
+	client := infra.NewDefaultDraftsClient(connector)
+
+	log.Printf("[INFO] Publishing PolicyDraft with ID %s", id)
+	err := client.Publish(id, model.Infra{})
+	if err != nil {
+		return logAPIError(fmt.Sprintf("Failed to publish PolicyDraft %s", id), err)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyFirewallDraftCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyFirewallDraftExists)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating PolicyDraft with ID %s", id)
+	err = policyFirewallDraftPatch(id, d, m)
+	if err != nil {
+		return handleCreateError("PolicyDraft", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	if d.Get("publish").(bool) {
+		err = policyFirewallDraftPublish(id, m)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceNsxtPolicyFirewallDraftRead(d, m)
+}
+
+func resourceNsxtPolicyFirewallDraftRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewDefaultDraftsClient(connector)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining PolicyDraft ID")
+	}
+
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "PolicyDraft", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("ref_draft_path", obj.RefDraftPath)
+	d.Set("locked", obj.Locked)
+	d.Set("lock_comments", obj.LockComments)
+	d.Set("is_auto_draft", obj.IsAutoDraft)
+
+	return nil
+}
+
+func resourceNsxtPolicyFirewallDraftUpdate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining PolicyDraft ID")
+	}
+
+	log.Printf("[INFO] Updating PolicyDraft with ID %s", id)
+	err := policyFirewallDraftPatch(id, d, m)
+	if err != nil {
+		return handleUpdateError("PolicyDraft", id, err)
+	}
+
+	// Publish is triggered when the flag is turned on
+	if d.HasChange("publish") && d.Get("publish").(bool) {
+		err = policyFirewallDraftPublish(id, m)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceNsxtPolicyFirewallDraftRead(d, m)
+}
+
+func resourceNsxtPolicyFirewallDraftDelete(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining PolicyDraft ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewDefaultDraftsClient(connector)
+	err := client.Delete(id)
+	if err != nil {
+		return handleDeleteError("PolicyDraft", id, err)
+	}
+
+	return nil
+}
+
+// Auto draft ID contains creation time, so that sorted IDs reflect the
+// order of captures
+func getPolicyFirewallAutoDraftID(now time.Time) string {
+	return policyFirewallAutoDraftPrefix + now.UTC().Format("20060102-150405")
+}
+
+func listPolicyFirewallAutoDraftIDs(connector *client.RestConnector) ([]string, error) {
+	client := infra.NewDefaultDraftsClient(connector)
+	autoDrafts := false
+	var ids []string
+	var cursor *string
+	for {
+		drafts, err := client.List(&autoDrafts, cursor, nil, nil, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, draft := range drafts.Results {
+			if draft.Id != nil && strings.HasPrefix(*draft.Id, policyFirewallAutoDraftPrefix) {
+				ids = append(ids, *draft.Id)
+			}
+		}
+		cursor = drafts.Cursor
+		if cursor == nil || *cursor == "" || len(drafts.Results) == 0 {
+			break
+		}
+	}
+
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// Returns auto drafts beyond the number of drafts to keep, oldest first
+func getPolicyFirewallAutoDraftsToDelete(ids []string) []string {
+	if len(ids) <= policyFirewallAutoDraftCount {
+		return nil
+	}
+	return ids[:len(ids)-policyFirewallAutoDraftCount]
+}
+
+// Capture current configuration in a new auto draft. Previous auto drafts
+// are kept, so that configuration prior to a failed apply can still be
+// restored after following runs, up to the number of drafts to keep.
+func createPolicyFirewallAutoDraft(connector *client.RestConnector) error {
+	client := infra.NewDefaultDraftsClient(connector)
+
+	id := getPolicyFirewallAutoDraftID(time.Now())
+	description := "Firewall configuration captured by terraform before changes were applied"
+	obj := model.PolicyDraft{
+		DisplayName: &id,
+		Description: &description,
+	}
+
+	log.Printf("[INFO] Creating firewall auto draft %s", id)
+	err := client.Patch(id, obj)
+	if err != nil {
+		return logAPIError("Failed to create firewall auto draft", err)
+	}
+
+	// Failure to clean up old drafts should not block the apply
+	ids, err := listPolicyFirewallAutoDraftIDs(connector)
+	if err != nil {
+		log.Printf("[WARNING] Failed to list firewall auto drafts: %v", err)
+		return nil
+	}
+	for _, oldID := range getPolicyFirewallAutoDraftsToDelete(ids) {
+		log.Printf("[INFO] Deleting old firewall auto draft %s", oldID)
+		err = client.Delete(oldID)
+		if err != nil && !isNotFoundError(err) {
+			log.Printf("[WARNING] Failed to delete firewall auto draft %s: %v", oldID, err)
+		}
+	}
+
+	return nil
+}
+
+// Should be called before any firewall change
+func ensurePolicyFirewallAutoDraft(m interface{}) error {
+	autoDraft := m.(nsxtClients).PolicyFirewallAutoDraft
+	if autoDraft == nil || isPolicyGlobalManager(m) {
+		return nil
+	}
+
+	autoDraft.once.Do(func() {
+		autoDraft.err = createPolicyFirewallAutoDraft(getPolicyConnector(m))
+	})
+
+	return autoDraft.err
+}
@@ -0,0 +1,272 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+)
+
+var accTestPolicyFirewallDraftCreateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform created",
+	"locked":       "false",
+}
+
+var accTestPolicyFirewallDraftUpdateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform updated",
+	"locked":       "true",
+}
+
+func TestAccResourceNsxtPolicyFirewallDraft_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_firewall_draft.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyFirewallDraftCheckDestroy(state, accTestPolicyFirewallDraftUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFirewallDraftTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyFirewallDraftExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyFirewallDraftCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyFirewallDraftCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "locked", accTestPolicyFirewallDraftCreateAttributes["locked"]),
+					resource.TestCheckResourceAttr(testResourceName, "is_auto_draft", "false"),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyFirewallDraftTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyFirewallDraftExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyFirewallDraftUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyFirewallDraftUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "locked", accTestPolicyFirewallDraftUpdateAttributes["locked"]),
+					resource.TestCheckResourceAttr(testResourceName, "is_auto_draft", "false"),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyFirewallDraftMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyFirewallDraftExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttr(testResourceName, "locked", "false"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyFirewallDraft_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_firewall_draft.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyFirewallDraftCheckDestroy(state, accTestPolicyFirewallDraftUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFirewallDraftMinimalistic(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyFirewallDraft_autoDraft(t *testing.T) {
+	name := getAccTestResourceName()
+	// Auto draft ID has second granularity
+	startID := getPolicyFirewallAutoDraftID(time.Now().Add(-time.Second))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			err := testAccNsxtPolicySecurityPolicyCheckDestroy(state, name, defaultDomain)
+			if err != nil {
+				return err
+			}
+			return testAccNsxtPolicyFirewallAutoDraftCleanup(startID)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFirewallAutoDraftTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicySecurityPolicyExists("nsxt_policy_security_policy.test", defaultDomain),
+					testAccNsxtPolicyFirewallAutoDraftExists(startID),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyFirewallDraftExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy PolicyDraft resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy PolicyDraft resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyFirewallDraftExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy PolicyDraft %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyFirewallDraftCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_firewall_draft" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyFirewallDraftExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy PolicyDraft %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyFirewallDraftTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyFirewallDraftCreateAttributes
+	} else {
+		attrMap = accTestPolicyFirewallDraftUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_firewall_draft" "test" {
+  display_name = "%s"
+  description  = "%s"
+  locked       = %s
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["locked"])
+}
+
+func testAccNsxtPolicyFirewallDraftMinimalistic() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_firewall_draft" "test" {
+  display_name = "%s"
+}`, accTestPolicyFirewallDraftUpdateAttributes["display_name"])
+}
+
+func testAccNsxtPolicyFirewallAutoDraftExists(startID string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+		ids, err := listPolicyFirewallAutoDraftIDs(connector)
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 || ids[len(ids)-1] < startID {
+			return fmt.Errorf("Firewall auto draft was not created by security policy change, found %v", ids)
+		}
+		return nil
+	}
+}
+
+// Delete auto drafts created during the test
+func testAccNsxtPolicyFirewallAutoDraftCleanup(startID string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	client := infra.NewDefaultDraftsClient(connector)
+	ids, err := listPolicyFirewallAutoDraftIDs(connector)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if id >= startID {
+			err = client.Delete(id)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func TestPolicyFirewallAutoDraftsToDelete(t *testing.T) {
+	now := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+	if id := getPolicyFirewallAutoDraftID(now); id != "terraform-auto-draft-20210304-050607" {
+		t.Errorf("Unexpected auto draft ID %s", id)
+	}
+
+	var ids []string
+	for i := 0; i < policyFirewallAutoDraftCount; i++ {
+		ids = append(ids, getPolicyFirewallAutoDraftID(now.Add(time.Duration(i)*time.Hour)))
+	}
+	if result := getPolicyFirewallAutoDraftsToDelete(ids); len(result) != 0 {
+		t.Errorf("Expected all drafts to be kept, got %v to delete", result)
+	}
+
+	ids = append(ids, getPolicyFirewallAutoDraftID(now.Add(24*time.Hour)))
+	result := getPolicyFirewallAutoDraftsToDelete(ids)
+	if len(result) != 1 || result[0] != ids[0] {
+		t.Errorf("Expected oldest draft %s to be deleted, got %v", ids[0], result)
+	}
+}
+
+func testAccNsxtPolicyFirewallAutoDraftTemplate(name string) string {
+	return fmt.Sprintf(`
+provider "nsxt" {
+  firewall_auto_draft = true
+}
+
+resource "nsxt_policy_security_policy" "test" {
+  display_name = "%s"
+  category     = "Application"
+}`, name)
+}
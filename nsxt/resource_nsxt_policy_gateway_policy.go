@@ -25,7 +25,8 @@ func resourceNsxtPolicyGatewayPolicy() *schema.Resource {
 			State: nsxtDomainResourceImporter,
 		},
 
-		Schema: getPolicyGatewayPolicySchema(),
+		Schema:        getPolicyGatewayPolicySchema(),
+		CustomizeDiff: policyPathsCustomizeDiff(policyRulePathReferences),
 	}
 }
 
@@ -101,7 +101,7 @@ func setPrefixesInSchema(d *schema.ResourceData, prefixes []model.PrefixEntry) {
 
 	err := d.Set("prefix", entriesList)
 	if err != nil {
-		log.Printf("[WARNING] Failed to set prefix in schema: %v", err)
+		addResourceWarning(d, "Failed to set prefix in schema", err.Error())
 	}
 }
 
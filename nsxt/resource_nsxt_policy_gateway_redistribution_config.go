@@ -72,7 +72,7 @@ func policyGatewayRedistributionConfigPatch(d *schema.ResourceData, m interface{
 		BgpEnabled: &bgpEnabled,
 	}
 
-	if nsxVersionHigherOrEqual("3.1.0") {
+	if nsxVersionHigherOrEqual(m, "3.1.0") {
 		redistributionStruct.OspfEnabled = &ospfEnabled
 	}
 
@@ -0,0 +1,246 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+)
+
+var globalManagerModeValues = []string{
+	gm_model.GlobalManager_MODE_ACTIVE,
+	gm_model.GlobalManager_MODE_STANDBY,
+}
+
+func resourceNsxtPolicyGlobalManager() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyGlobalManagerCreate,
+		Read:   resourceNsxtPolicyGlobalManagerRead,
+		Update: resourceNsxtPolicyGlobalManagerUpdate,
+		Delete: resourceNsxtPolicyGlobalManagerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: nsxVersionCustomizeDiff("3.1.0"),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(policyFullSyncDefaultTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"mode": {
+				Type:         schema.TypeString,
+				Description:  "Mode of the Global Manager",
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(globalManagerModeValues, false),
+			},
+			"fail_if_rtt_exceeded": getPolicyFailIfRttExceededSchema(),
+			"maximum_rtt":          getPolicyMaximumRttSchema(),
+			"connection_info":      getPolicySiteConnectionInfoSchema("Connection information of the Global Manager"),
+			"rtep_config": {
+				Type:        schema.TypeList,
+				Description: "Federation remote tunnel endpoint configuration, distributed to all sites. Applicable for active Global Manager only",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ibgp_password": {
+							Type:        schema.TypeString,
+							Description: "Password to authenticate IBGP sessions between remote tunnel endpoints of federated sites",
+							Optional:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyGlobalManagerExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	client := gm_infra.NewDefaultGlobalManagersClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving GlobalManager", err)
+}
+
+func policyGlobalManagerPatch(id string, d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	client := gm_infra.NewDefaultGlobalManagersClient(connector)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyGlobalManagerTagsFromSchema(d)
+	mode := d.Get("mode").(string)
+	failIfRttExceeded := d.Get("fail_if_rtt_exceeded").(bool)
+	maximumRtt := int64(d.Get("maximum_rtt").(int))
+
+	obj := gm_model.GlobalManager{
+		DisplayName:       &displayName,
+		Description:       &description,
+		Tags:              tags,
+		Mode:              &mode,
+		FailIfRttExceeded: &failIfRttExceeded,
+		MaximumRtt:        &maximumRtt,
+		ConnectionInfo:    getPolicySiteConnectionInfoFromSchema(d, "connection_info"),
+	}
+
+	return client.Patch(id, obj, nil)
+}
+
+// RTEP configuration is global, and thus is not removed with the resource
+func policyGlobalManagerRtepConfigPatch(d *schema.ResourceData, m interface{}) error {
+	rtepConfigs := d.Get("rtep_config").([]interface{})
+	if len(rtepConfigs) == 0 || rtepConfigs[0] == nil {
+		return nil
+	}
+
+	if d.Get("mode").(string) != gm_model.GlobalManager_MODE_ACTIVE {
+		return fmt.Errorf("rtep_config is applicable for active Global Manager only")
+	}
+
+	data := rtepConfigs[0].(map[string]interface{})
+	ibgpPassword := data["ibgp_password"].(string)
+	obj := gm_model.GlobalManagerConfig{
+		RtepConfig: &gm_model.GmRtepConfig{
+			IbgpPassword: &ibgpPassword,
+		},
+	}
+
+	client := gm_infra.NewDefaultGlobalManagerConfigClient(getPolicyConnector(m))
+	return client.Patch(obj)
+}
+
+func resourceNsxtPolicyGlobalManagerCreate(d *schema.ResourceData, m interface{}) error {
+	if !isPolicyGlobalManager(m) {
+		return globalManagerOnlyError()
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyGlobalManagerExists)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating GlobalManager with ID %s", id)
+	err = policyGlobalManagerPatch(id, d, m)
+	if err != nil {
+		return handleCreateError("GlobalManager", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	err = policyGlobalManagerRtepConfigPatch(d, m)
+	if err != nil {
+		return handleCreateError("GlobalManagerConfig", id, err)
+	}
+
+	// Only standby Global Manager is synced from the active one
+	if d.Get("mode").(string) == gm_model.GlobalManager_MODE_STANDBY {
+		err = policyWaitForFullSync(m, id, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceNsxtPolicyGlobalManagerRead(d, m)
+}
+
+func resourceNsxtPolicyGlobalManagerRead(d *schema.ResourceData, m interface{}) error {
+	if !isPolicyGlobalManager(m) {
+		return globalManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	client := gm_infra.NewDefaultGlobalManagersClient(connector)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining GlobalManager ID")
+	}
+
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "GlobalManager", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyGlobalManagerTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("mode", obj.Mode)
+	d.Set("fail_if_rtt_exceeded", obj.FailIfRttExceeded)
+	d.Set("maximum_rtt", obj.MaximumRtt)
+	setPolicySiteConnectionInfoInSchema(d, "connection_info", obj.ConnectionInfo)
+
+	return nil
+}
+
+func resourceNsxtPolicyGlobalManagerUpdate(d *schema.ResourceData, m interface{}) error {
+	if !isPolicyGlobalManager(m) {
+		return globalManagerOnlyError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining GlobalManager ID")
+	}
+
+	log.Printf("[INFO] Updating GlobalManager with ID %s", id)
+	err := policyGlobalManagerPatch(id, d, m)
+	if err != nil {
+		return handleUpdateError("GlobalManager", id, err)
+	}
+
+	if d.HasChange("rtep_config") || d.HasChange("mode") {
+		err = policyGlobalManagerRtepConfigPatch(d, m)
+		if err != nil {
+			return handleUpdateError("GlobalManagerConfig", id, err)
+		}
+	}
+
+	return resourceNsxtPolicyGlobalManagerRead(d, m)
+}
+
+func resourceNsxtPolicyGlobalManagerDelete(d *schema.ResourceData, m interface{}) error {
+	if !isPolicyGlobalManager(m) {
+		return globalManagerOnlyError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining GlobalManager ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := gm_infra.NewDefaultGlobalManagersClient(connector)
+	err := client.Delete(id)
+	if err != nil {
+		return handleDeleteError("GlobalManager", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,177 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyGlobalManagerCreateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform created",
+	"maximum_rtt":  "250",
+}
+
+var accTestPolicyGlobalManagerUpdateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform updated",
+	"maximum_rtt":  "500",
+}
+
+func testAccPolicyGlobalManagerPreCheck(t *testing.T) {
+	testAccOnlyGlobalManager(t)
+	testAccEnvDefined(t, "NSXT_TEST_STANDBY_GM_HOST")
+	testAccEnvDefined(t, "NSXT_TEST_STANDBY_GM_USERNAME")
+	testAccEnvDefined(t, "NSXT_TEST_STANDBY_GM_PASSWORD")
+	testAccPreCheck(t)
+}
+
+func TestAccResourceNsxtPolicyGlobalManager_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_global_manager.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPolicyGlobalManagerPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyGlobalManagerCheckDestroy(state, accTestPolicyGlobalManagerUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGlobalManagerTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyGlobalManagerExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyGlobalManagerCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyGlobalManagerCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "maximum_rtt", accTestPolicyGlobalManagerCreateAttributes["maximum_rtt"]),
+					resource.TestCheckResourceAttr(testResourceName, "mode", "STANDBY"),
+					resource.TestCheckResourceAttr(testResourceName, "connection_info.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "connection_info.0.thumbprint"),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyGlobalManagerTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyGlobalManagerExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyGlobalManagerUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyGlobalManagerUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "maximum_rtt", accTestPolicyGlobalManagerUpdateAttributes["maximum_rtt"]),
+					resource.TestCheckResourceAttr(testResourceName, "connection_info.#", "1"),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyGlobalManager_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_global_manager.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPolicyGlobalManagerPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyGlobalManagerCheckDestroy(state, accTestPolicyGlobalManagerUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGlobalManagerTemplate(false),
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"connection_info.0.password"},
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyGlobalManagerExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy GlobalManager resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy GlobalManager resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyGlobalManagerExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy GlobalManager %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyGlobalManagerCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_global_manager" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyGlobalManagerExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy GlobalManager %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyGlobalManagerTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyGlobalManagerCreateAttributes
+	} else {
+		attrMap = accTestPolicyGlobalManagerUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_global_manager" "test" {
+  display_name = "%s"
+  description  = "%s"
+  maximum_rtt  = %s
+  mode         = "STANDBY"
+
+  connection_info {
+    fqdn     = "%s"
+    username = "%s"
+    password = "%s"
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["maximum_rtt"], os.Getenv("NSXT_TEST_STANDBY_GM_HOST"), os.Getenv("NSXT_TEST_STANDBY_GM_USERNAME"), os.Getenv("NSXT_TEST_STANDBY_GM_PASSWORD"))
+}
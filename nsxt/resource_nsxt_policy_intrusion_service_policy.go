@@ -27,7 +27,8 @@ func resourceNsxtPolicyIntrusionServicePolicy() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: nsxtDomainResourceImporter,
 		},
-		Schema: getPolicySecurityPolicySchema(true),
+		CustomizeDiff: nsxVersionCustomizeDiff("3.1.0"),
+		Schema:        getPolicySecurityPolicySchema(true),
 	}
 }
 
@@ -290,7 +291,7 @@ func updateIdsSecurityPolicy(id string, d *schema.ResourceData, m interface{}) e
 func idsPolicyInfraPatch(policy model.IdsSecurityPolicy, domain string, m interface{}) error {
 	childDomain, err := createChildDomainWithIdsSecurityPolicy(domain, *policy.Id, policy)
 	if err != nil {
-		return fmt.Errorf("Failed to create H-API for Ids Policy: %s", err)
+		return fmt.Errorf("Failed to create H-API for Ids Policy: %w", err)
 	}
 
 	var infraChildren []*data.StructValue
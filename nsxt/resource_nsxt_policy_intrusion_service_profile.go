@@ -46,6 +46,7 @@ func resourceNsxtPolicyIntrusionServiceProfile() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: nsxVersionCustomizeDiff("3.1.0"),
 
 		Schema: map[string]*schema.Schema{
 			"nsx_id":       getNsxIDSchema(),
@@ -357,7 +358,7 @@ func resourceNsxtPolicyIntrusionServiceProfileCreate(d *schema.ResourceData, m i
 	tags := getPolicyTagsFromSchema(d)
 	criteria, err := getIdsProfileCriteriaFromSchema(d)
 	if err != nil {
-		return fmt.Errorf("Failed to read criteria from Ids Profile: %v", err)
+		return fmt.Errorf("Failed to read criteria from Ids Profile: %w", err)
 	}
 	signatures := getIdsProfileSignaturesFromSchema(d)
 	profileSeverity := getStringListFromSchemaSet(d, "severities")
@@ -433,7 +434,7 @@ func resourceNsxtPolicyIntrusionServiceProfileUpdate(d *schema.ResourceData, m i
 	tags := getPolicyTagsFromSchema(d)
 	criteria, err := getIdsProfileCriteriaFromSchema(d)
 	if err != nil {
-		return fmt.Errorf("Failed to read criteria from Ids Profile: %v", err)
+		return fmt.Errorf("Failed to read criteria from Ids Profile: %w", err)
 	}
 	signatures := getIdsProfileSignaturesFromSchema(d)
 	profileSeverity := getStringListFromSchemaSet(d, "severities")
@@ -68,13 +68,13 @@ func resourceNsxtPolicyIPAddressAllocationExists(poolID string, allocationID str
 }
 
 func resourceNsxtPolicyIPAddressAllocationCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
 	connector := getPolicyConnector(m)
 	client := ip_pools.NewDefaultIpAllocationsClient(connector)
 
-	if client == nil {
-		return policyResourceNotSupportedError()
-	}
-
 	poolID := getPolicyIDFromPath(d.Get("pool_path").(string))
 
 	id := d.Get("nsx_id").(string)
@@ -120,13 +120,13 @@ func resourceNsxtPolicyIPAddressAllocationCreate(d *schema.ResourceData, m inter
 }
 
 func resourceNsxtPolicyIPAddressAllocationRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
 	connector := getPolicyConnector(m)
 	client := ip_pools.NewDefaultIpAllocationsClient(connector)
 
-	if client == nil {
-		return policyResourceNotSupportedError()
-	}
-
 	id := d.Id()
 	if id == "" {
 		return fmt.Errorf("Error obtaining IPAddressAllocation ID")
@@ -153,7 +153,7 @@ func resourceNsxtPolicyIPAddressAllocationRead(d *schema.ResourceData, m interfa
 		log.Printf("[DEBUG] Waiting for realization of IP Address for IP Allocation with ID %s", id)
 
 		stateConf := nsxtPolicyWaitForRealizationStateConf(connector, d, d.Get("path").(string))
-		entity, err := stateConf.WaitForState()
+		entity, err := stateConf.WaitForStateContext(getProviderContext(m))
 		if err != nil {
 			return err
 		}
@@ -171,11 +171,12 @@ func resourceNsxtPolicyIPAddressAllocationRead(d *schema.ResourceData, m interfa
 }
 
 func resourceNsxtPolicyIPAddressAllocationDelete(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
 	connector := getPolicyConnector(m)
 	client := ip_pools.NewDefaultIpAllocationsClient(connector)
-	if client == nil {
-		return policyResourceNotSupportedError()
-	}
 
 	id := d.Id()
 	if id == "" {
@@ -13,7 +13,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
-	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/ip_pools"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/realized_state"
@@ -212,13 +211,13 @@ func resourceNsxtPolicyIPPoolBlockSubnetDelete(d *schema.ResourceData, m interfa
 		return handleDeleteError("Block Subnet", id, err)
 	}
 
-	return resourceNsxtPolicyIPPoolBlockSubnetVerifyDelete(d, connector)
+	return resourceNsxtPolicyIPPoolBlockSubnetVerifyDelete(d, m)
 }
 
 // NOTE: This will not be needed when IPAM is handled by NSXT Policy
-func resourceNsxtPolicyIPPoolBlockSubnetVerifyDelete(d *schema.ResourceData, connector *client.RestConnector) error {
+func resourceNsxtPolicyIPPoolBlockSubnetVerifyDelete(d *schema.ResourceData, m interface{}) error {
 
-	client := realized_state.NewDefaultRealizedEntitiesClient(connector)
+	client := realized_state.NewDefaultRealizedEntitiesClient(getPolicyConnector(m))
 
 	path := d.Get("path").(string)
 	// Wait for realization state to disappear (not_found) - this means
@@ -245,9 +244,9 @@ func resourceNsxtPolicyIPPoolBlockSubnetVerifyDelete(d *schema.ResourceData, con
 		MinTimeout: 1 * time.Second,
 		Delay:      1 * time.Second,
 	}
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(getProviderContext(m))
 	if err != nil {
-		return fmt.Errorf("Failed to confirm delete realization for %s: %v", path, err)
+		return fmt.Errorf("Failed to confirm delete realization for %s: %w", path, err)
 	}
 
 	return nil
@@ -0,0 +1,207 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyLBClientSslProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyLBClientSslProfileCreate,
+		Read:   resourceNsxtPolicyLBClientSslProfileRead,
+		Update: resourceNsxtPolicyLBClientSslProfileUpdate,
+		Delete: resourceNsxtPolicyLBClientSslProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: nsxVersionCustomizeDiff("3.0.0"),
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":             getNsxIDSchema(),
+			"path":               getPathSchema(),
+			"display_name":       getDisplayNameSchema(),
+			"description":        getDescriptionSchema(),
+			"revision":           getRevisionSchema(),
+			"tag":                getTagsSchema(),
+			"cipher_group_label": getPolicyLbSslCipherGroupLabelSchema(),
+			"ciphers":            getSSLCiphersSchema(),
+			"is_fips":            getPolicyLbSslIsFipsSchema(),
+			"is_secure":          getIsSecureSchema(),
+			"prefer_server_ciphers": {
+				Type:        schema.TypeBool,
+				Description: "Allow server to override the client's preference",
+				Optional:    true,
+				Default:     true,
+			},
+			"protocols": getSSLProtocolsSchema(),
+			"session_cache_enabled": {
+				Type:        schema.TypeBool,
+				Description: "Reuse previously negotiated security parameters during handshake",
+				Optional:    true,
+				Default:     true,
+			},
+			"session_cache_timeout": {
+				Type:         schema.TypeInt,
+				Description:  "For how long the SSL session parameters can be reused",
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntBetween(1, 86400),
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyLBClientSslProfileExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	client := infra.NewDefaultLbClientSslProfilesClient(connector)
+
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving LBClientSslProfile", err)
+}
+
+func policyLBClientSslProfilePatch(id string, d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	client := infra.NewDefaultLbClientSslProfilesClient(connector)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	cipherGroupLabel := d.Get("cipher_group_label").(string)
+	preferServerCiphers := d.Get("prefer_server_ciphers").(bool)
+	sessionCacheEnabled := d.Get("session_cache_enabled").(bool)
+	sessionCacheTimeout := int64(d.Get("session_cache_timeout").(int))
+
+	obj := model.LBClientSslProfile{
+		DisplayName:         &displayName,
+		Description:         &description,
+		Tags:                tags,
+		CipherGroupLabel:    &cipherGroupLabel,
+		PreferServerCiphers: &preferServerCiphers,
+		Protocols:           getStringListFromSchemaSet(d, "protocols"),
+		SessionCacheEnabled: &sessionCacheEnabled,
+		SessionCacheTimeout: &sessionCacheTimeout,
+	}
+
+	// Ciphers are determined by NSX unless cipher group is CUSTOM
+	if cipherGroupLabel == model.LBClientSslProfile_CIPHER_GROUP_LABEL_CUSTOM {
+		obj.Ciphers = getStringListFromSchemaSet(d, "ciphers")
+	}
+
+	return client.Patch(id, obj)
+}
+
+func resourceNsxtPolicyLBClientSslProfileCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyLBClientSslProfileExists)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating LBClientSslProfile with ID %s", id)
+	err = policyLBClientSslProfilePatch(id, d, m)
+	if err != nil {
+		return handleCreateError("LBClientSslProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyLBClientSslProfileRead(d, m)
+}
+
+func resourceNsxtPolicyLBClientSslProfileRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewDefaultLbClientSslProfilesClient(connector)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBClientSslProfile ID")
+	}
+
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "LBClientSslProfile", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("cipher_group_label", obj.CipherGroupLabel)
+	d.Set("ciphers", obj.Ciphers)
+	d.Set("is_fips", obj.IsFips)
+	d.Set("is_secure", obj.IsSecure)
+	d.Set("prefer_server_ciphers", obj.PreferServerCiphers)
+	d.Set("protocols", obj.Protocols)
+	d.Set("session_cache_enabled", obj.SessionCacheEnabled)
+	d.Set("session_cache_timeout", obj.SessionCacheTimeout)
+
+	return nil
+}
+
+func resourceNsxtPolicyLBClientSslProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBClientSslProfile ID")
+	}
+
+	log.Printf("[INFO] Updating LBClientSslProfile with ID %s", id)
+	err := policyLBClientSslProfilePatch(id, d, m)
+	if err != nil {
+		return handleUpdateError("LBClientSslProfile", id, err)
+	}
+
+	return resourceNsxtPolicyLBClientSslProfileRead(d, m)
+}
+
+func resourceNsxtPolicyLBClientSslProfileDelete(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBClientSslProfile ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewDefaultLbClientSslProfilesClient(connector)
+	force := true
+	err := client.Delete(id, &force)
+	if err != nil {
+		return handleDeleteError("LBClientSslProfile", id, err)
+	}
+
+	return nil
+}
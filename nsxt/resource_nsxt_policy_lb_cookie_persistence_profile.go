@@ -0,0 +1,330 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var lbCookiePersistenceModeValues = []string{
+	model.LBCookiePersistenceProfile_COOKIE_MODE_INSERT,
+	model.LBCookiePersistenceProfile_COOKIE_MODE_PREFIX,
+	model.LBCookiePersistenceProfile_COOKIE_MODE_REWRITE,
+}
+
+var lbCookieTimeTypeValues = []string{
+	model.LBCookieTime_TYPE_LBSESSIONCOOKIETIME,
+	model.LBCookieTime_TYPE_LBPERSISTENCECOOKIETIME,
+}
+
+func resourceNsxtPolicyLBCookiePersistenceProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyLBCookiePersistenceProfileCreate,
+		Read:   resourceNsxtPolicyLBCookiePersistenceProfileRead,
+		Update: resourceNsxtPolicyLBCookiePersistenceProfileUpdate,
+		Delete: resourceNsxtPolicyLBPersistenceProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: nsxVersionCustomizeDiff("3.0.0"),
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"cookie_mode": {
+				Type:         schema.TypeString,
+				Description:  "The cookie persistence mode",
+				Optional:     true,
+				Default:      model.LBCookiePersistenceProfile_COOKIE_MODE_INSERT,
+				ValidateFunc: validation.StringInSlice(lbCookiePersistenceModeValues, false),
+			},
+			"cookie_name": {
+				Type:        schema.TypeString,
+				Description: "The name of the cookie",
+				Required:    true,
+			},
+			"cookie_domain": {
+				Type:        schema.TypeString,
+				Description: "HTTP cookie domain, only available for INSERT mode",
+				Optional:    true,
+			},
+			"cookie_path": {
+				Type:        schema.TypeString,
+				Description: "HTTP cookie path, only available for INSERT mode",
+				Optional:    true,
+			},
+			"cookie_fallback": {
+				Type:        schema.TypeBool,
+				Description: "If true, once the server pointed by this cookie is down, a new server is selected. Otherwise, the requests will be rejected",
+				Optional:    true,
+				Default:     true,
+			},
+			"cookie_garble": {
+				Type:        schema.TypeBool,
+				Description: "A boolean flag which reflects whether the cookie value (server IP and port) would be encrypted or in plain text",
+				Optional:    true,
+				Default:     true,
+			},
+			"cookie_httponly": {
+				Type:        schema.TypeBool,
+				Description: "If true, the cookie is not accessible to client side scripts. Only available for INSERT mode",
+				Optional:    true,
+				Default:     false,
+			},
+			"cookie_secure": {
+				Type:        schema.TypeBool,
+				Description: "If true, the cookie is sent only over HTTPS. Only available for INSERT mode",
+				Optional:    true,
+				Default:     false,
+			},
+			"cookie_time_type": {
+				Type:         schema.TypeString,
+				Description:  "Type of cookie expiration timing, only available for INSERT mode. If not specified, the cookie is a session cookie that expires when the browser is closed",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(lbCookieTimeTypeValues, false),
+			},
+			"cookie_max_idle": {
+				Type:         schema.TypeInt,
+				Description:  "Maximum interval (in seconds) the cookie is valid for from the last time it was seen in a request",
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"cookie_max_life": {
+				Type:         schema.TypeInt,
+				Description:  "Maximum interval (in seconds) the cookie is valid for from the first time it was seen in a request, only applicable for session cookie time",
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"persistence_shared": {
+				Type:        schema.TypeBool,
+				Description: "If true, persistence entries are shared among virtual servers referring to this profile",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func getPolicyLbCookieTimeFromSchema(d *schema.ResourceData) (*data.StructValue, error) {
+	timeType := d.Get("cookie_time_type").(string)
+	maxIdle := int64(d.Get("cookie_max_idle").(int))
+	maxLife := int64(d.Get("cookie_max_life").(int))
+
+	if timeType == model.LBCookieTime_TYPE_LBSESSIONCOOKIETIME {
+		cookieTime := model.LBSessionCookieTime{
+			Type_: timeType,
+		}
+		if maxIdle > 0 {
+			cookieTime.CookieMaxIdle = &maxIdle
+		}
+		if maxLife > 0 {
+			cookieTime.CookieMaxLife = &maxLife
+		}
+		return policyLbProfileToStructValue(cookieTime, model.LBSessionCookieTimeBindingType())
+	}
+
+	if timeType == model.LBCookieTime_TYPE_LBPERSISTENCECOOKIETIME {
+		if maxIdle == 0 {
+			return nil, fmt.Errorf("cookie_max_idle is required for %s", timeType)
+		}
+		cookieTime := model.LBPersistenceCookieTime{
+			Type_:         timeType,
+			CookieMaxIdle: &maxIdle,
+		}
+		return policyLbProfileToStructValue(cookieTime, model.LBPersistenceCookieTimeBindingType())
+	}
+
+	return nil, nil
+}
+
+func setPolicyLbCookieTimeInSchema(d *schema.ResourceData, cookieTime *data.StructValue) error {
+	if cookieTime == nil {
+		d.Set("cookie_time_type", "")
+		d.Set("cookie_max_idle", nil)
+		d.Set("cookie_max_life", nil)
+		return nil
+	}
+
+	baseTime, err := policyLbProfileFromStructValue(cookieTime, model.LBCookieTimeBindingType())
+	if err != nil {
+		return err
+	}
+
+	timeType := baseTime.(model.LBCookieTime).Type_
+	d.Set("cookie_time_type", timeType)
+	if timeType == model.LBCookieTime_TYPE_LBSESSIONCOOKIETIME {
+		sessionTime, err := policyLbProfileFromStructValue(cookieTime, model.LBSessionCookieTimeBindingType())
+		if err != nil {
+			return err
+		}
+		d.Set("cookie_max_idle", sessionTime.(model.LBSessionCookieTime).CookieMaxIdle)
+		d.Set("cookie_max_life", sessionTime.(model.LBSessionCookieTime).CookieMaxLife)
+		return nil
+	}
+
+	persistenceTime, err := policyLbProfileFromStructValue(cookieTime, model.LBPersistenceCookieTimeBindingType())
+	if err != nil {
+		return err
+	}
+	d.Set("cookie_max_idle", persistenceTime.(model.LBPersistenceCookieTime).CookieMaxIdle)
+	d.Set("cookie_max_life", nil)
+
+	return nil
+}
+
+func policyLBCookiePersistenceProfilePatch(id string, d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	client := infra.NewDefaultLbPersistenceProfilesClient(connector)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	cookieMode := d.Get("cookie_mode").(string)
+	cookieName := d.Get("cookie_name").(string)
+	cookieFallback := d.Get("cookie_fallback").(bool)
+	cookieGarble := d.Get("cookie_garble").(bool)
+	persistenceShared := d.Get("persistence_shared").(bool)
+
+	obj := model.LBCookiePersistenceProfile{
+		DisplayName:       &displayName,
+		Description:       &description,
+		Tags:              tags,
+		CookieMode:        &cookieMode,
+		CookieName:        &cookieName,
+		CookieFallback:    &cookieFallback,
+		CookieGarble:      &cookieGarble,
+		PersistenceShared: &persistenceShared,
+		ResourceType:      model.LBPersistenceProfile_RESOURCE_TYPE_LBCOOKIEPERSISTENCEPROFILE,
+	}
+
+	// The following settings are only available for INSERT mode
+	if cookieMode == model.LBCookiePersistenceProfile_COOKIE_MODE_INSERT {
+		cookieHttponly := d.Get("cookie_httponly").(bool)
+		cookieSecure := d.Get("cookie_secure").(bool)
+		obj.CookieHttponly = &cookieHttponly
+		obj.CookieSecure = &cookieSecure
+
+		cookieDomain := d.Get("cookie_domain").(string)
+		if cookieDomain != "" {
+			obj.CookieDomain = &cookieDomain
+		}
+		cookiePath := d.Get("cookie_path").(string)
+		if cookiePath != "" {
+			obj.CookiePath = &cookiePath
+		}
+
+		cookieTime, err := getPolicyLbCookieTimeFromSchema(d)
+		if err != nil {
+			return err
+		}
+		obj.CookieTime = cookieTime
+	}
+
+	dataValue, err := policyLbProfileToStructValue(obj, model.LBCookiePersistenceProfileBindingType())
+	if err != nil {
+		return err
+	}
+
+	return client.Patch(id, dataValue)
+}
+
+func resourceNsxtPolicyLBCookiePersistenceProfileCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyLBPersistenceProfileExists)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating LBCookiePersistenceProfile with ID %s", id)
+	err = policyLBCookiePersistenceProfilePatch(id, d, m)
+	if err != nil {
+		return handleCreateError("LBCookiePersistenceProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyLBCookiePersistenceProfileRead(d, m)
+}
+
+func resourceNsxtPolicyLBCookiePersistenceProfileRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewDefaultLbPersistenceProfilesClient(connector)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBCookiePersistenceProfile ID")
+	}
+
+	dataValue, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "LBCookiePersistenceProfile", id, err)
+	}
+
+	profile, err := policyLbProfileFromStructValue(dataValue, model.LBCookiePersistenceProfileBindingType())
+	if err != nil {
+		return err
+	}
+	obj := profile.(model.LBCookiePersistenceProfile)
+	if obj.ResourceType != model.LBPersistenceProfile_RESOURCE_TYPE_LBCOOKIEPERSISTENCEPROFILE {
+		return fmt.Errorf("LB Persistence Profile %s is of type %s", id, obj.ResourceType)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("cookie_mode", obj.CookieMode)
+	d.Set("cookie_name", obj.CookieName)
+	d.Set("cookie_domain", obj.CookieDomain)
+	d.Set("cookie_path", obj.CookiePath)
+	d.Set("cookie_fallback", obj.CookieFallback)
+	d.Set("cookie_garble", obj.CookieGarble)
+	d.Set("cookie_httponly", obj.CookieHttponly)
+	d.Set("cookie_secure", obj.CookieSecure)
+	d.Set("persistence_shared", obj.PersistenceShared)
+
+	return setPolicyLbCookieTimeInSchema(d, obj.CookieTime)
+}
+
+func resourceNsxtPolicyLBCookiePersistenceProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBCookiePersistenceProfile ID")
+	}
+
+	log.Printf("[INFO] Updating LBCookiePersistenceProfile with ID %s", id)
+	err := policyLBCookiePersistenceProfilePatch(id, d, m)
+	if err != nil {
+		return handleUpdateError("LBCookiePersistenceProfile", id, err)
+	}
+
+	return resourceNsxtPolicyLBCookiePersistenceProfileRead(d, m)
+}
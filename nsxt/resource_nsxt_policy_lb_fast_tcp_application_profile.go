@@ -0,0 +1,168 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyLBFastTCPApplicationProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyLBFastTCPApplicationProfileCreate,
+		Read:   resourceNsxtPolicyLBFastTCPApplicationProfileRead,
+		Update: resourceNsxtPolicyLBFastTCPApplicationProfileUpdate,
+		Delete: resourceNsxtPolicyLBAppProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: nsxVersionCustomizeDiff("3.0.0"),
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"close_timeout": {
+				Type:         schema.TypeInt,
+				Description:  "Timeout in seconds to specify how long a closed TCP connection should be kept for this application before cleaning up the connection",
+				Optional:     true,
+				Default:      8,
+				ValidateFunc: validation.IntBetween(1, 60),
+			},
+			"idle_timeout": {
+				Type:         schema.TypeInt,
+				Description:  "Timeout in seconds to specify how long an idle TCP connection in ESTABLISHED state should be kept for this application before cleaning up",
+				Optional:     true,
+				Default:      1800,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"ha_flow_mirroring_enabled": {
+				Type:        schema.TypeBool,
+				Description: "A boolean flag which reflects whether flow mirroring is enabled, and all the flows to the bounded virtual server are mirrored to the standby node",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func policyLBFastTCPApplicationProfilePatch(id string, d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	client := infra.NewDefaultLbAppProfilesClient(connector)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	closeTimeout := int64(d.Get("close_timeout").(int))
+	idleTimeout := int64(d.Get("idle_timeout").(int))
+	haFlowMirroringEnabled := d.Get("ha_flow_mirroring_enabled").(bool)
+
+	obj := model.LBFastTcpProfile{
+		DisplayName:            &displayName,
+		Description:            &description,
+		Tags:                   tags,
+		CloseTimeout:           &closeTimeout,
+		IdleTimeout:            &idleTimeout,
+		HaFlowMirroringEnabled: &haFlowMirroringEnabled,
+		ResourceType:           model.LBAppProfile_RESOURCE_TYPE_LBFASTTCPPROFILE,
+	}
+
+	dataValue, err := policyLbProfileToStructValue(obj, model.LBFastTcpProfileBindingType())
+	if err != nil {
+		return err
+	}
+
+	return client.Patch(id, dataValue)
+}
+
+func resourceNsxtPolicyLBFastTCPApplicationProfileCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyLBAppProfileExists)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating LBFastTcpProfile with ID %s", id)
+	err = policyLBFastTCPApplicationProfilePatch(id, d, m)
+	if err != nil {
+		return handleCreateError("LBFastTcpProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyLBFastTCPApplicationProfileRead(d, m)
+}
+
+func resourceNsxtPolicyLBFastTCPApplicationProfileRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewDefaultLbAppProfilesClient(connector)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBFastTcpProfile ID")
+	}
+
+	dataValue, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "LBFastTcpProfile", id, err)
+	}
+
+	profile, err := policyLbProfileFromStructValue(dataValue, model.LBFastTcpProfileBindingType())
+	if err != nil {
+		return err
+	}
+	obj := profile.(model.LBFastTcpProfile)
+	if obj.ResourceType != model.LBAppProfile_RESOURCE_TYPE_LBFASTTCPPROFILE {
+		return fmt.Errorf("LB Application Profile %s is of type %s", id, obj.ResourceType)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("close_timeout", obj.CloseTimeout)
+	d.Set("idle_timeout", obj.IdleTimeout)
+	d.Set("ha_flow_mirroring_enabled", obj.HaFlowMirroringEnabled)
+
+	return nil
+}
+
+func resourceNsxtPolicyLBFastTCPApplicationProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBFastTcpProfile ID")
+	}
+
+	log.Printf("[INFO] Updating LBFastTcpProfile with ID %s", id)
+	err := policyLBFastTCPApplicationProfilePatch(id, d, m)
+	if err != nil {
+		return handleUpdateError("LBFastTcpProfile", id, err)
+	}
+
+	return resourceNsxtPolicyLBFastTCPApplicationProfileRead(d, m)
+}
@@ -0,0 +1,184 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyLBFastTCPApplicationProfileCreateAttributes = map[string]string{
+	"display_name":              getAccTestResourceName(),
+	"description":               "terraform created",
+	"close_timeout":             "10",
+	"idle_timeout":              "1000",
+	"ha_flow_mirroring_enabled": "true",
+}
+
+var accTestPolicyLBFastTCPApplicationProfileUpdateAttributes = map[string]string{
+	"display_name":              getAccTestResourceName(),
+	"description":               "terraform updated",
+	"close_timeout":             "20",
+	"idle_timeout":              "2000",
+	"ha_flow_mirroring_enabled": "false",
+}
+
+func TestAccResourceNsxtPolicyLBFastTCPApplicationProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_lb_fast_tcp_application_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBFastTCPApplicationProfileCheckDestroy(state, accTestPolicyLBFastTCPApplicationProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBFastTCPApplicationProfileTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBFastTCPApplicationProfileExists(accTestPolicyLBFastTCPApplicationProfileCreateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyLBFastTCPApplicationProfileCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyLBFastTCPApplicationProfileCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "close_timeout", accTestPolicyLBFastTCPApplicationProfileCreateAttributes["close_timeout"]),
+					resource.TestCheckResourceAttr(testResourceName, "idle_timeout", accTestPolicyLBFastTCPApplicationProfileCreateAttributes["idle_timeout"]),
+					resource.TestCheckResourceAttr(testResourceName, "ha_flow_mirroring_enabled", accTestPolicyLBFastTCPApplicationProfileCreateAttributes["ha_flow_mirroring_enabled"]),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLBFastTCPApplicationProfileTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBFastTCPApplicationProfileExists(accTestPolicyLBFastTCPApplicationProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyLBFastTCPApplicationProfileUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyLBFastTCPApplicationProfileUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "close_timeout", accTestPolicyLBFastTCPApplicationProfileUpdateAttributes["close_timeout"]),
+					resource.TestCheckResourceAttr(testResourceName, "idle_timeout", accTestPolicyLBFastTCPApplicationProfileUpdateAttributes["idle_timeout"]),
+					resource.TestCheckResourceAttr(testResourceName, "ha_flow_mirroring_enabled", accTestPolicyLBFastTCPApplicationProfileUpdateAttributes["ha_flow_mirroring_enabled"]),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLBFastTCPApplicationProfileMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBFastTCPApplicationProfileExists(accTestPolicyLBFastTCPApplicationProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyLBFastTCPApplicationProfile_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_lb_fast_tcp_application_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBFastTCPApplicationProfileCheckDestroy(state, accTestPolicyLBFastTCPApplicationProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBFastTCPApplicationProfileMinimalistic(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyLBFastTCPApplicationProfileExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy LB Fast TCP Application Profile resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy LB Fast TCP Application Profile resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyLBAppProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy LB Fast TCP Application Profile %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyLBFastTCPApplicationProfileCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_lb_fast_tcp_application_profile" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyLBAppProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err == nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy LB Fast TCP Application Profile %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyLBFastTCPApplicationProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	var tags string
+	if createFlow {
+		attrMap = accTestPolicyLBFastTCPApplicationProfileCreateAttributes
+		tags = `
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }`
+	} else {
+		attrMap = accTestPolicyLBFastTCPApplicationProfileUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_lb_fast_tcp_application_profile" "test" {
+  display_name              = "%s"
+  description               = "%s"
+  close_timeout             = %s
+  idle_timeout              = %s
+  ha_flow_mirroring_enabled = %s
+%s
+}`, attrMap["display_name"], attrMap["description"], attrMap["close_timeout"], attrMap["idle_timeout"], attrMap["ha_flow_mirroring_enabled"], tags)
+}
+
+func testAccNsxtPolicyLBFastTCPApplicationProfileMinimalistic() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_lb_fast_tcp_application_profile" "test" {
+  display_name = "%s"
+}`, accTestPolicyLBFastTCPApplicationProfileUpdateAttributes["display_name"])
+}
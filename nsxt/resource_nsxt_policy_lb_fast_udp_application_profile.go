@@ -0,0 +1,158 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyLBFastUDPApplicationProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyLBFastUDPApplicationProfileCreate,
+		Read:   resourceNsxtPolicyLBFastUDPApplicationProfileRead,
+		Update: resourceNsxtPolicyLBFastUDPApplicationProfileUpdate,
+		Delete: resourceNsxtPolicyLBAppProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: nsxVersionCustomizeDiff("3.0.0"),
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"idle_timeout": {
+				Type:         schema.TypeInt,
+				Description:  "Timeout in seconds to specify how long an idle UDP flow should be kept for this application before cleaning up",
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"flow_mirroring_enabled": {
+				Type:        schema.TypeBool,
+				Description: "A boolean flag which reflects whether flow mirroring is enabled, and all the flows to the bounded virtual server are mirrored to the standby node",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func policyLBFastUDPApplicationProfilePatch(id string, d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	client := infra.NewDefaultLbAppProfilesClient(connector)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	idleTimeout := int64(d.Get("idle_timeout").(int))
+	flowMirroringEnabled := d.Get("flow_mirroring_enabled").(bool)
+
+	obj := model.LBFastUdpProfile{
+		DisplayName:          &displayName,
+		Description:          &description,
+		Tags:                 tags,
+		IdleTimeout:          &idleTimeout,
+		FlowMirroringEnabled: &flowMirroringEnabled,
+		ResourceType:         model.LBAppProfile_RESOURCE_TYPE_LBFASTUDPPROFILE,
+	}
+
+	dataValue, err := policyLbProfileToStructValue(obj, model.LBFastUdpProfileBindingType())
+	if err != nil {
+		return err
+	}
+
+	return client.Patch(id, dataValue)
+}
+
+func resourceNsxtPolicyLBFastUDPApplicationProfileCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyLBAppProfileExists)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating LBFastUdpProfile with ID %s", id)
+	err = policyLBFastUDPApplicationProfilePatch(id, d, m)
+	if err != nil {
+		return handleCreateError("LBFastUdpProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyLBFastUDPApplicationProfileRead(d, m)
+}
+
+func resourceNsxtPolicyLBFastUDPApplicationProfileRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewDefaultLbAppProfilesClient(connector)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBFastUdpProfile ID")
+	}
+
+	dataValue, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "LBFastUdpProfile", id, err)
+	}
+
+	profile, err := policyLbProfileFromStructValue(dataValue, model.LBFastUdpProfileBindingType())
+	if err != nil {
+		return err
+	}
+	obj := profile.(model.LBFastUdpProfile)
+	if obj.ResourceType != model.LBAppProfile_RESOURCE_TYPE_LBFASTUDPPROFILE {
+		return fmt.Errorf("LB Application Profile %s is of type %s", id, obj.ResourceType)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("idle_timeout", obj.IdleTimeout)
+	d.Set("flow_mirroring_enabled", obj.FlowMirroringEnabled)
+
+	return nil
+}
+
+func resourceNsxtPolicyLBFastUDPApplicationProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBFastUdpProfile ID")
+	}
+
+	log.Printf("[INFO] Updating LBFastUdpProfile with ID %s", id)
+	err := policyLBFastUDPApplicationProfilePatch(id, d, m)
+	if err != nil {
+		return handleUpdateError("LBFastUdpProfile", id, err)
+	}
+
+	return resourceNsxtPolicyLBFastUDPApplicationProfileRead(d, m)
+}
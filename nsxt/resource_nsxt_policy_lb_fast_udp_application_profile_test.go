@@ -0,0 +1,179 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyLBFastUDPApplicationProfileCreateAttributes = map[string]string{
+	"display_name":           getAccTestResourceName(),
+	"description":            "terraform created",
+	"idle_timeout":           "100",
+	"flow_mirroring_enabled": "true",
+}
+
+var accTestPolicyLBFastUDPApplicationProfileUpdateAttributes = map[string]string{
+	"display_name":           getAccTestResourceName(),
+	"description":            "terraform updated",
+	"idle_timeout":           "200",
+	"flow_mirroring_enabled": "false",
+}
+
+func TestAccResourceNsxtPolicyLBFastUDPApplicationProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_lb_fast_udp_application_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBFastUDPApplicationProfileCheckDestroy(state, accTestPolicyLBFastUDPApplicationProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBFastUDPApplicationProfileTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBFastUDPApplicationProfileExists(accTestPolicyLBFastUDPApplicationProfileCreateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyLBFastUDPApplicationProfileCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyLBFastUDPApplicationProfileCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "idle_timeout", accTestPolicyLBFastUDPApplicationProfileCreateAttributes["idle_timeout"]),
+					resource.TestCheckResourceAttr(testResourceName, "flow_mirroring_enabled", accTestPolicyLBFastUDPApplicationProfileCreateAttributes["flow_mirroring_enabled"]),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLBFastUDPApplicationProfileTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBFastUDPApplicationProfileExists(accTestPolicyLBFastUDPApplicationProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyLBFastUDPApplicationProfileUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyLBFastUDPApplicationProfileUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "idle_timeout", accTestPolicyLBFastUDPApplicationProfileUpdateAttributes["idle_timeout"]),
+					resource.TestCheckResourceAttr(testResourceName, "flow_mirroring_enabled", accTestPolicyLBFastUDPApplicationProfileUpdateAttributes["flow_mirroring_enabled"]),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLBFastUDPApplicationProfileMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBFastUDPApplicationProfileExists(accTestPolicyLBFastUDPApplicationProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyLBFastUDPApplicationProfile_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_lb_fast_udp_application_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBFastUDPApplicationProfileCheckDestroy(state, accTestPolicyLBFastUDPApplicationProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBFastUDPApplicationProfileMinimalistic(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyLBFastUDPApplicationProfileExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy LB Fast UDP Application Profile resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy LB Fast UDP Application Profile resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyLBAppProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy LB Fast UDP Application Profile %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyLBFastUDPApplicationProfileCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_lb_fast_udp_application_profile" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyLBAppProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err == nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy LB Fast UDP Application Profile %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyLBFastUDPApplicationProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	var tags string
+	if createFlow {
+		attrMap = accTestPolicyLBFastUDPApplicationProfileCreateAttributes
+		tags = `
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }`
+	} else {
+		attrMap = accTestPolicyLBFastUDPApplicationProfileUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_lb_fast_udp_application_profile" "test" {
+  display_name           = "%s"
+  description            = "%s"
+  idle_timeout           = %s
+  flow_mirroring_enabled = %s
+%s
+}`, attrMap["display_name"], attrMap["description"], attrMap["idle_timeout"], attrMap["flow_mirroring_enabled"], tags)
+}
+
+func testAccNsxtPolicyLBFastUDPApplicationProfileMinimalistic() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_lb_fast_udp_application_profile" "test" {
+  display_name = "%s"
+}`, accTestPolicyLBFastUDPApplicationProfileUpdateAttributes["display_name"])
+}
@@ -0,0 +1,167 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyLBGenericPersistenceProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyLBGenericPersistenceProfileCreate,
+		Read:   resourceNsxtPolicyLBGenericPersistenceProfileRead,
+		Update: resourceNsxtPolicyLBGenericPersistenceProfileUpdate,
+		Delete: resourceNsxtPolicyLBPersistenceProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: nsxVersionCustomizeDiff("3.0.0"),
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"ha_persistence_mirroring_enabled": {
+				Type:        schema.TypeBool,
+				Description: "If true, persistence entries will be synchronized to the HA peer",
+				Optional:    true,
+				Default:     false,
+			},
+			"persistence_shared": {
+				Type:        schema.TypeBool,
+				Description: "If true, persistence entries are shared among virtual servers referring to this profile",
+				Optional:    true,
+				Default:     false,
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Description:  "Persistence expiration time in seconds, counted from the time all the connections are completed",
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func policyLBGenericPersistenceProfilePatch(id string, d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	client := infra.NewDefaultLbPersistenceProfilesClient(connector)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	haPersistenceMirroringEnabled := d.Get("ha_persistence_mirroring_enabled").(bool)
+	persistenceShared := d.Get("persistence_shared").(bool)
+	timeout := int64(d.Get("timeout").(int))
+
+	obj := model.LBGenericPersistenceProfile{
+		DisplayName:                   &displayName,
+		Description:                   &description,
+		Tags:                          tags,
+		HaPersistenceMirroringEnabled: &haPersistenceMirroringEnabled,
+		PersistenceShared:             &persistenceShared,
+		Timeout:                       &timeout,
+		ResourceType:                  model.LBPersistenceProfile_RESOURCE_TYPE_LBGENERICPERSISTENCEPROFILE,
+	}
+
+	dataValue, err := policyLbProfileToStructValue(obj, model.LBGenericPersistenceProfileBindingType())
+	if err != nil {
+		return err
+	}
+
+	return client.Patch(id, dataValue)
+}
+
+func resourceNsxtPolicyLBGenericPersistenceProfileCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyLBPersistenceProfileExists)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating LBGenericPersistenceProfile with ID %s", id)
+	err = policyLBGenericPersistenceProfilePatch(id, d, m)
+	if err != nil {
+		return handleCreateError("LBGenericPersistenceProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyLBGenericPersistenceProfileRead(d, m)
+}
+
+func resourceNsxtPolicyLBGenericPersistenceProfileRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewDefaultLbPersistenceProfilesClient(connector)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBGenericPersistenceProfile ID")
+	}
+
+	dataValue, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "LBGenericPersistenceProfile", id, err)
+	}
+
+	profile, err := policyLbProfileFromStructValue(dataValue, model.LBGenericPersistenceProfileBindingType())
+	if err != nil {
+		return err
+	}
+	obj := profile.(model.LBGenericPersistenceProfile)
+	if obj.ResourceType != model.LBPersistenceProfile_RESOURCE_TYPE_LBGENERICPERSISTENCEPROFILE {
+		return fmt.Errorf("LB Persistence Profile %s is of type %s", id, obj.ResourceType)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("ha_persistence_mirroring_enabled", obj.HaPersistenceMirroringEnabled)
+	d.Set("persistence_shared", obj.PersistenceShared)
+	d.Set("timeout", obj.Timeout)
+
+	return nil
+}
+
+func resourceNsxtPolicyLBGenericPersistenceProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBGenericPersistenceProfile ID")
+	}
+
+	log.Printf("[INFO] Updating LBGenericPersistenceProfile with ID %s", id)
+	err := policyLBGenericPersistenceProfilePatch(id, d, m)
+	if err != nil {
+		return handleUpdateError("LBGenericPersistenceProfile", id, err)
+	}
+
+	return resourceNsxtPolicyLBGenericPersistenceProfileRead(d, m)
+}
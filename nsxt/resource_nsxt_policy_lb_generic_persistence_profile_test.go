@@ -0,0 +1,184 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyLBGenericPersistenceProfileCreateAttributes = map[string]string{
+	"display_name":                     getAccTestResourceName(),
+	"description":                      "terraform created",
+	"ha_persistence_mirroring_enabled": "true",
+	"persistence_shared":               "true",
+	"timeout":                          "100",
+}
+
+var accTestPolicyLBGenericPersistenceProfileUpdateAttributes = map[string]string{
+	"display_name":                     getAccTestResourceName(),
+	"description":                      "terraform updated",
+	"ha_persistence_mirroring_enabled": "false",
+	"persistence_shared":               "false",
+	"timeout":                          "200",
+}
+
+func TestAccResourceNsxtPolicyLBGenericPersistenceProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_lb_generic_persistence_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t); testAccNSXVersion(t, "3.0.0") },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBGenericPersistenceProfileCheckDestroy(state, accTestPolicyLBGenericPersistenceProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBGenericPersistenceProfileTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBGenericPersistenceProfileExists(accTestPolicyLBGenericPersistenceProfileCreateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyLBGenericPersistenceProfileCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyLBGenericPersistenceProfileCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "ha_persistence_mirroring_enabled", accTestPolicyLBGenericPersistenceProfileCreateAttributes["ha_persistence_mirroring_enabled"]),
+					resource.TestCheckResourceAttr(testResourceName, "persistence_shared", accTestPolicyLBGenericPersistenceProfileCreateAttributes["persistence_shared"]),
+					resource.TestCheckResourceAttr(testResourceName, "timeout", accTestPolicyLBGenericPersistenceProfileCreateAttributes["timeout"]),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLBGenericPersistenceProfileTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBGenericPersistenceProfileExists(accTestPolicyLBGenericPersistenceProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyLBGenericPersistenceProfileUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyLBGenericPersistenceProfileUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "ha_persistence_mirroring_enabled", accTestPolicyLBGenericPersistenceProfileUpdateAttributes["ha_persistence_mirroring_enabled"]),
+					resource.TestCheckResourceAttr(testResourceName, "persistence_shared", accTestPolicyLBGenericPersistenceProfileUpdateAttributes["persistence_shared"]),
+					resource.TestCheckResourceAttr(testResourceName, "timeout", accTestPolicyLBGenericPersistenceProfileUpdateAttributes["timeout"]),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLBGenericPersistenceProfileMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBGenericPersistenceProfileExists(accTestPolicyLBGenericPersistenceProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyLBGenericPersistenceProfile_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_lb_generic_persistence_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t); testAccNSXVersion(t, "3.0.0") },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBGenericPersistenceProfileCheckDestroy(state, accTestPolicyLBGenericPersistenceProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBGenericPersistenceProfileMinimalistic(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyLBGenericPersistenceProfileExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy LB Generic Persistence Profile resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy LB Generic Persistence Profile resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyLBPersistenceProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy LB Generic Persistence Profile %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyLBGenericPersistenceProfileCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_lb_generic_persistence_profile" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyLBPersistenceProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err == nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy LB Generic Persistence Profile %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyLBGenericPersistenceProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	var tags string
+	if createFlow {
+		attrMap = accTestPolicyLBGenericPersistenceProfileCreateAttributes
+		tags = `
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }`
+	} else {
+		attrMap = accTestPolicyLBGenericPersistenceProfileUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_lb_generic_persistence_profile" "test" {
+  display_name                     = "%s"
+  description                      = "%s"
+  ha_persistence_mirroring_enabled = %s
+  persistence_shared               = %s
+  timeout                          = %s
+%s
+}`, attrMap["display_name"], attrMap["description"], attrMap["ha_persistence_mirroring_enabled"], attrMap["persistence_shared"], attrMap["timeout"], tags)
+}
+
+func testAccNsxtPolicyLBGenericPersistenceProfileMinimalistic() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_lb_generic_persistence_profile" "test" {
+  display_name = "%s"
+}`, accTestPolicyLBGenericPersistenceProfileUpdateAttributes["display_name"])
+}
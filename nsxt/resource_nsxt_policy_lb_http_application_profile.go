@@ -0,0 +1,255 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var lbHTTPProfileXForwardedForValues = []string{
+	model.LBHttpProfile_XFORWARDED_FOR_INSERT,
+	model.LBHttpProfile_XFORWARDED_FOR_REPLACE,
+}
+
+func resourceNsxtPolicyLBHTTPApplicationProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyLBHTTPApplicationProfileCreate,
+		Read:   resourceNsxtPolicyLBHTTPApplicationProfileRead,
+		Update: resourceNsxtPolicyLBHTTPApplicationProfileUpdate,
+		Delete: resourceNsxtPolicyLBAppProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: nsxVersionCustomizeDiff("3.0.0"),
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"http_redirect_to": {
+				Type:        schema.TypeString,
+				Description: "A URL that incoming requests for that virtual server can be temporarily redirected to, if a website is temporarily down or has moved",
+				Optional:    true,
+			},
+			"http_redirect_to_https": {
+				Type:        schema.TypeBool,
+				Description: "A boolean flag which reflects whether the client will automatically be redirected to use SSL",
+				Optional:    true,
+				Default:     false,
+			},
+			"idle_timeout": {
+				Type:         schema.TypeInt,
+				Description:  "Timeout in seconds to specify how long an HTTP application can remain idle",
+				Optional:     true,
+				Default:      15,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"ntlm": {
+				Type:        schema.TypeBool,
+				Description: "A boolean flag which reflects whether NTLM challenge/response methodology will be used over HTTP",
+				Optional:    true,
+				Default:     false,
+			},
+			"request_body_size": {
+				Type:         schema.TypeInt,
+				Description:  "Maximum request body size in bytes (unlimited if not specified)",
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"request_header_size": {
+				Type:         schema.TypeInt,
+				Description:  "Maximum request header size in bytes. Requests with larger header size will be processed as best effort whereas a request with header below this specified size is guaranteed to be processed",
+				Optional:     true,
+				Default:      1024,
+				ValidateFunc: validation.IntBetween(1, 65536),
+			},
+			"response_buffering": {
+				Type:        schema.TypeBool,
+				Description: "A boolean flag indicating whether the response received by LB from the backend will be saved into the buffers",
+				Optional:    true,
+				Default:     false,
+			},
+			"response_header_size": {
+				Type:         schema.TypeInt,
+				Description:  "Maximum size of the buffer used to store HTTP response headers",
+				Optional:     true,
+				Default:      4096,
+				ValidateFunc: validation.IntBetween(1, 65536),
+			},
+			"response_timeout": {
+				Type:         schema.TypeInt,
+				Description:  "Number of seconds waiting for the server response before the connection is closed",
+				Optional:     true,
+				Default:      60,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"server_keep_alive": {
+				Type:        schema.TypeBool,
+				Description: "A boolean flag indicating whether the backend connection will be kept alive for client connection",
+				Optional:    true,
+				Default:     false,
+			},
+			"x_forwarded_for": {
+				Type:         schema.TypeString,
+				Description:  "When this value is set, the x_forwarded_for header in the incoming request will be inserted or replaced",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(lbHTTPProfileXForwardedForValues, false),
+			},
+		},
+	}
+}
+
+func policyLBHTTPApplicationProfilePatch(id string, d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	client := infra.NewDefaultLbAppProfilesClient(connector)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	httpRedirectToHTTPS := d.Get("http_redirect_to_https").(bool)
+	idleTimeout := int64(d.Get("idle_timeout").(int))
+	ntlm := d.Get("ntlm").(bool)
+	requestHeaderSize := int64(d.Get("request_header_size").(int))
+	responseBuffering := d.Get("response_buffering").(bool)
+	responseHeaderSize := int64(d.Get("response_header_size").(int))
+	responseTimeout := int64(d.Get("response_timeout").(int))
+	serverKeepAlive := d.Get("server_keep_alive").(bool)
+
+	obj := model.LBHttpProfile{
+		DisplayName:         &displayName,
+		Description:         &description,
+		Tags:                tags,
+		HttpRedirectToHttps: &httpRedirectToHTTPS,
+		IdleTimeout:         &idleTimeout,
+		Ntlm:                &ntlm,
+		RequestHeaderSize:   &requestHeaderSize,
+		ResponseBuffering:   &responseBuffering,
+		ResponseHeaderSize:  &responseHeaderSize,
+		ResponseTimeout:     &responseTimeout,
+		ServerKeepAlive:     &serverKeepAlive,
+		ResourceType:        model.LBAppProfile_RESOURCE_TYPE_LBHTTPPROFILE,
+	}
+
+	httpRedirectTo := d.Get("http_redirect_to").(string)
+	if httpRedirectTo != "" {
+		obj.HttpRedirectTo = &httpRedirectTo
+	}
+
+	requestBodySize := int64(d.Get("request_body_size").(int))
+	if requestBodySize > 0 {
+		obj.RequestBodySize = &requestBodySize
+	}
+
+	xForwardedFor := d.Get("x_forwarded_for").(string)
+	if xForwardedFor != "" {
+		obj.XForwardedFor = &xForwardedFor
+	}
+
+	dataValue, err := policyLbProfileToStructValue(obj, model.LBHttpProfileBindingType())
+	if err != nil {
+		return err
+	}
+
+	return client.Patch(id, dataValue)
+}
+
+func resourceNsxtPolicyLBHTTPApplicationProfileCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyLBAppProfileExists)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating LBHttpProfile with ID %s", id)
+	err = policyLBHTTPApplicationProfilePatch(id, d, m)
+	if err != nil {
+		return handleCreateError("LBHttpProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyLBHTTPApplicationProfileRead(d, m)
+}
+
+func resourceNsxtPolicyLBHTTPApplicationProfileRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewDefaultLbAppProfilesClient(connector)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBHttpProfile ID")
+	}
+
+	dataValue, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "LBHttpProfile", id, err)
+	}
+
+	profile, err := policyLbProfileFromStructValue(dataValue, model.LBHttpProfileBindingType())
+	if err != nil {
+		return err
+	}
+	obj := profile.(model.LBHttpProfile)
+	if obj.ResourceType != model.LBAppProfile_RESOURCE_TYPE_LBHTTPPROFILE {
+		return fmt.Errorf("LB Application Profile %s is of type %s", id, obj.ResourceType)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("http_redirect_to", obj.HttpRedirectTo)
+	d.Set("http_redirect_to_https", obj.HttpRedirectToHttps)
+	d.Set("idle_timeout", obj.IdleTimeout)
+	d.Set("ntlm", obj.Ntlm)
+	d.Set("request_body_size", obj.RequestBodySize)
+	d.Set("request_header_size", obj.RequestHeaderSize)
+	d.Set("response_buffering", obj.ResponseBuffering)
+	d.Set("response_header_size", obj.ResponseHeaderSize)
+	d.Set("response_timeout", obj.ResponseTimeout)
+	d.Set("server_keep_alive", obj.ServerKeepAlive)
+	d.Set("x_forwarded_for", obj.XForwardedFor)
+
+	return nil
+}
+
+func resourceNsxtPolicyLBHTTPApplicationProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBHttpProfile ID")
+	}
+
+	log.Printf("[INFO] Updating LBHttpProfile with ID %s", id)
+	err := policyLBHTTPApplicationProfilePatch(id, d, m)
+	if err != nil {
+		return handleUpdateError("LBHttpProfile", id, err)
+	}
+
+	return resourceNsxtPolicyLBHTTPApplicationProfileRead(d, m)
+}
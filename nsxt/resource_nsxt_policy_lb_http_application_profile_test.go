@@ -0,0 +1,209 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyLBHTTPApplicationProfileCreateAttributes = map[string]string{
+	"display_name":         getAccTestResourceName(),
+	"description":          "terraform created",
+	"http_redirect_to":     "http://www.example.com",
+	"idle_timeout":         "20",
+	"request_body_size":    "1024",
+	"request_header_size":  "2048",
+	"response_header_size": "8192",
+	"response_timeout":     "80",
+	"server_keep_alive":    "true",
+	"x_forwarded_for":      "INSERT",
+}
+
+var accTestPolicyLBHTTPApplicationProfileUpdateAttributes = map[string]string{
+	"display_name":         getAccTestResourceName(),
+	"description":          "terraform updated",
+	"http_redirect_to":     "http://www.example.org",
+	"idle_timeout":         "30",
+	"request_body_size":    "2048",
+	"request_header_size":  "1024",
+	"response_header_size": "4096",
+	"response_timeout":     "60",
+	"server_keep_alive":    "false",
+	"x_forwarded_for":      "REPLACE",
+}
+
+func TestAccResourceNsxtPolicyLBHTTPApplicationProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_lb_http_application_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBHTTPApplicationProfileCheckDestroy(state, accTestPolicyLBHTTPApplicationProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBHTTPApplicationProfileTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBHTTPApplicationProfileExists(accTestPolicyLBHTTPApplicationProfileCreateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyLBHTTPApplicationProfileCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyLBHTTPApplicationProfileCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "http_redirect_to", accTestPolicyLBHTTPApplicationProfileCreateAttributes["http_redirect_to"]),
+					resource.TestCheckResourceAttr(testResourceName, "idle_timeout", accTestPolicyLBHTTPApplicationProfileCreateAttributes["idle_timeout"]),
+					resource.TestCheckResourceAttr(testResourceName, "request_body_size", accTestPolicyLBHTTPApplicationProfileCreateAttributes["request_body_size"]),
+					resource.TestCheckResourceAttr(testResourceName, "request_header_size", accTestPolicyLBHTTPApplicationProfileCreateAttributes["request_header_size"]),
+					resource.TestCheckResourceAttr(testResourceName, "response_header_size", accTestPolicyLBHTTPApplicationProfileCreateAttributes["response_header_size"]),
+					resource.TestCheckResourceAttr(testResourceName, "response_timeout", accTestPolicyLBHTTPApplicationProfileCreateAttributes["response_timeout"]),
+					resource.TestCheckResourceAttr(testResourceName, "server_keep_alive", accTestPolicyLBHTTPApplicationProfileCreateAttributes["server_keep_alive"]),
+					resource.TestCheckResourceAttr(testResourceName, "x_forwarded_for", accTestPolicyLBHTTPApplicationProfileCreateAttributes["x_forwarded_for"]),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLBHTTPApplicationProfileTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBHTTPApplicationProfileExists(accTestPolicyLBHTTPApplicationProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyLBHTTPApplicationProfileUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyLBHTTPApplicationProfileUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "http_redirect_to", accTestPolicyLBHTTPApplicationProfileUpdateAttributes["http_redirect_to"]),
+					resource.TestCheckResourceAttr(testResourceName, "idle_timeout", accTestPolicyLBHTTPApplicationProfileUpdateAttributes["idle_timeout"]),
+					resource.TestCheckResourceAttr(testResourceName, "request_body_size", accTestPolicyLBHTTPApplicationProfileUpdateAttributes["request_body_size"]),
+					resource.TestCheckResourceAttr(testResourceName, "request_header_size", accTestPolicyLBHTTPApplicationProfileUpdateAttributes["request_header_size"]),
+					resource.TestCheckResourceAttr(testResourceName, "response_header_size", accTestPolicyLBHTTPApplicationProfileUpdateAttributes["response_header_size"]),
+					resource.TestCheckResourceAttr(testResourceName, "response_timeout", accTestPolicyLBHTTPApplicationProfileUpdateAttributes["response_timeout"]),
+					resource.TestCheckResourceAttr(testResourceName, "server_keep_alive", accTestPolicyLBHTTPApplicationProfileUpdateAttributes["server_keep_alive"]),
+					resource.TestCheckResourceAttr(testResourceName, "x_forwarded_for", accTestPolicyLBHTTPApplicationProfileUpdateAttributes["x_forwarded_for"]),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLBHTTPApplicationProfileMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBHTTPApplicationProfileExists(accTestPolicyLBHTTPApplicationProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyLBHTTPApplicationProfile_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_lb_http_application_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBHTTPApplicationProfileCheckDestroy(state, accTestPolicyLBHTTPApplicationProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBHTTPApplicationProfileMinimalistic(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyLBHTTPApplicationProfileExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy LB HTTP Application Profile resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy LB HTTP Application Profile resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyLBAppProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy LB HTTP Application Profile %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyLBHTTPApplicationProfileCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_lb_http_application_profile" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyLBAppProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err == nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy LB HTTP Application Profile %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyLBHTTPApplicationProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	var tags string
+	if createFlow {
+		attrMap = accTestPolicyLBHTTPApplicationProfileCreateAttributes
+		tags = `
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }`
+	} else {
+		attrMap = accTestPolicyLBHTTPApplicationProfileUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_lb_http_application_profile" "test" {
+  display_name         = "%s"
+  description          = "%s"
+  http_redirect_to     = "%s"
+  idle_timeout         = %s
+  request_body_size    = %s
+  request_header_size  = %s
+  response_header_size = %s
+  response_timeout     = %s
+  server_keep_alive    = %s
+  x_forwarded_for      = "%s"
+%s
+}`, attrMap["display_name"], attrMap["description"], attrMap["http_redirect_to"], attrMap["idle_timeout"], attrMap["request_body_size"], attrMap["request_header_size"], attrMap["response_header_size"], attrMap["response_timeout"], attrMap["server_keep_alive"], attrMap["x_forwarded_for"], tags)
+}
+
+func testAccNsxtPolicyLBHTTPApplicationProfileMinimalistic() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_lb_http_application_profile" "test" {
+  display_name = "%s"
+}`, accTestPolicyLBHTTPApplicationProfileUpdateAttributes["display_name"])
+}
@@ -0,0 +1,230 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyLBHTTPMonitorProfileCreateAttributes = map[string]string{
+	"display_name":    getAccTestResourceName(),
+	"description":     "terraform created",
+	"fall_count":      "2",
+	"interval":        "4",
+	"monitor_port":    "8080",
+	"rise_count":      "5",
+	"timeout":         "10",
+	"request_body":    "ping",
+	"request_method":  "POST",
+	"request_url":     "/health",
+	"request_version": "HTTP_VERSION_1_0",
+	"response_body":   "pong",
+}
+
+var accTestPolicyLBHTTPMonitorProfileUpdateAttributes = map[string]string{
+	"display_name":    getAccTestResourceName(),
+	"description":     "terraform updated",
+	"fall_count":      "3",
+	"interval":        "5",
+	"monitor_port":    "8090",
+	"rise_count":      "3",
+	"timeout":         "15",
+	"request_body":    "ping2",
+	"request_method":  "PUT",
+	"request_url":     "/healthz",
+	"request_version": "HTTP_VERSION_1_1",
+	"response_body":   "pong2",
+}
+
+func TestAccResourceNsxtPolicyLBHTTPMonitorProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_lb_http_monitor_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBHTTPMonitorProfileCheckDestroy(state, accTestPolicyLBHTTPMonitorProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBHTTPMonitorProfileTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBHTTPMonitorProfileExists(accTestPolicyLBHTTPMonitorProfileCreateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyLBHTTPMonitorProfileCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyLBHTTPMonitorProfileCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "fall_count", accTestPolicyLBHTTPMonitorProfileCreateAttributes["fall_count"]),
+					resource.TestCheckResourceAttr(testResourceName, "interval", accTestPolicyLBHTTPMonitorProfileCreateAttributes["interval"]),
+					resource.TestCheckResourceAttr(testResourceName, "monitor_port", accTestPolicyLBHTTPMonitorProfileCreateAttributes["monitor_port"]),
+					resource.TestCheckResourceAttr(testResourceName, "rise_count", accTestPolicyLBHTTPMonitorProfileCreateAttributes["rise_count"]),
+					resource.TestCheckResourceAttr(testResourceName, "timeout", accTestPolicyLBHTTPMonitorProfileCreateAttributes["timeout"]),
+					resource.TestCheckResourceAttr(testResourceName, "request_body", accTestPolicyLBHTTPMonitorProfileCreateAttributes["request_body"]),
+					resource.TestCheckResourceAttr(testResourceName, "request_method", accTestPolicyLBHTTPMonitorProfileCreateAttributes["request_method"]),
+					resource.TestCheckResourceAttr(testResourceName, "request_url", accTestPolicyLBHTTPMonitorProfileCreateAttributes["request_url"]),
+					resource.TestCheckResourceAttr(testResourceName, "request_version", accTestPolicyLBHTTPMonitorProfileCreateAttributes["request_version"]),
+					resource.TestCheckResourceAttr(testResourceName, "response_body", accTestPolicyLBHTTPMonitorProfileCreateAttributes["response_body"]),
+					resource.TestCheckResourceAttr(testResourceName, "response_status_codes.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "request_header.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLBHTTPMonitorProfileTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBHTTPMonitorProfileExists(accTestPolicyLBHTTPMonitorProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyLBHTTPMonitorProfileUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyLBHTTPMonitorProfileUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "fall_count", accTestPolicyLBHTTPMonitorProfileUpdateAttributes["fall_count"]),
+					resource.TestCheckResourceAttr(testResourceName, "interval", accTestPolicyLBHTTPMonitorProfileUpdateAttributes["interval"]),
+					resource.TestCheckResourceAttr(testResourceName, "monitor_port", accTestPolicyLBHTTPMonitorProfileUpdateAttributes["monitor_port"]),
+					resource.TestCheckResourceAttr(testResourceName, "rise_count", accTestPolicyLBHTTPMonitorProfileUpdateAttributes["rise_count"]),
+					resource.TestCheckResourceAttr(testResourceName, "timeout", accTestPolicyLBHTTPMonitorProfileUpdateAttributes["timeout"]),
+					resource.TestCheckResourceAttr(testResourceName, "request_body", accTestPolicyLBHTTPMonitorProfileUpdateAttributes["request_body"]),
+					resource.TestCheckResourceAttr(testResourceName, "request_method", accTestPolicyLBHTTPMonitorProfileUpdateAttributes["request_method"]),
+					resource.TestCheckResourceAttr(testResourceName, "request_url", accTestPolicyLBHTTPMonitorProfileUpdateAttributes["request_url"]),
+					resource.TestCheckResourceAttr(testResourceName, "request_version", accTestPolicyLBHTTPMonitorProfileUpdateAttributes["request_version"]),
+					resource.TestCheckResourceAttr(testResourceName, "response_body", accTestPolicyLBHTTPMonitorProfileUpdateAttributes["response_body"]),
+					resource.TestCheckResourceAttr(testResourceName, "response_status_codes.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "request_header.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLBHTTPMonitorProfileMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBHTTPMonitorProfileExists(accTestPolicyLBHTTPMonitorProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyLBHTTPMonitorProfile_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_lb_http_monitor_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBHTTPMonitorProfileCheckDestroy(state, accTestPolicyLBHTTPMonitorProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBHTTPMonitorProfileMinimalistic(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyLBHTTPMonitorProfileExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy LB HTTP Monitor Profile resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy LB HTTP Monitor Profile resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyLBMonitorProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy LB HTTP Monitor Profile %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyLBHTTPMonitorProfileCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_lb_http_monitor_profile" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyLBMonitorProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err == nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy LB HTTP Monitor Profile %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyLBHTTPMonitorProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	var tags string
+	if createFlow {
+		attrMap = accTestPolicyLBHTTPMonitorProfileCreateAttributes
+		tags = `
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }`
+	} else {
+		attrMap = accTestPolicyLBHTTPMonitorProfileUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_lb_http_monitor_profile" "test" {
+  display_name    = "%s"
+  description     = "%s"
+  fall_count      = %s
+  interval        = %s
+  monitor_port    = "%s"
+  rise_count      = %s
+  timeout         = %s
+  request_body    = "%s"
+  request_method  = "%s"
+  request_url     = "%s"
+  request_version = "%s"
+  response_body   = "%s"
+
+  response_status_codes = [200, 304]
+
+  request_header {
+    name  = "header1"
+    value = "value1"
+  }
+%s
+}`, attrMap["display_name"], attrMap["description"], attrMap["fall_count"], attrMap["interval"], attrMap["monitor_port"], attrMap["rise_count"], attrMap["timeout"], attrMap["request_body"], attrMap["request_method"], attrMap["request_url"], attrMap["request_version"], attrMap["response_body"], tags)
+}
+
+func testAccNsxtPolicyLBHTTPMonitorProfileMinimalistic() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_lb_http_monitor_profile" "test" {
+  display_name = "%s"
+}`, accTestPolicyLBHTTPMonitorProfileUpdateAttributes["display_name"])
+}
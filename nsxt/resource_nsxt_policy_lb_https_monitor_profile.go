@@ -0,0 +1,192 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyLBHTTPSMonitorProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyLBHTTPSMonitorProfileCreate,
+		Read:   resourceNsxtPolicyLBHTTPSMonitorProfileRead,
+		Update: resourceNsxtPolicyLBHTTPSMonitorProfileUpdate,
+		Delete: resourceNsxtPolicyLBMonitorProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: nsxVersionCustomizeDiff("3.0.0"),
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":                getNsxIDSchema(),
+			"path":                  getPathSchema(),
+			"display_name":          getDisplayNameSchema(),
+			"description":           getDescriptionSchema(),
+			"revision":              getRevisionSchema(),
+			"tag":                   getTagsSchema(),
+			"fall_count":            getLbMonitorFallCountSchema(),
+			"interval":              getLbMonitorIntervalSchema(),
+			"monitor_port":          getLbMonitorPortSchema(),
+			"rise_count":            getLbMonitorRiseCountSchema(),
+			"timeout":               getLbMonitorTimeoutSchema(),
+			"request_body":          getLbMonitorRequestBodySchema(),
+			"request_header":        getLbHTTPHeaderSchema("Array of HTTP request headers"),
+			"request_method":        getLbMonitorRequestMethodSchema(),
+			"request_url":           getLbMonitorRequestURLSchema(),
+			"request_version":       getLbMonitorRequestVersionSchema(),
+			"response_body":         getLbMonitorResponseBodySchema(),
+			"response_status_codes": getLbMonitorResponseStatusCodesSchema(),
+			"server_ssl": {
+				Type:        schema.TypeList,
+				Description: "SSL settings used by the monitor when connecting to the backend server",
+				Elem:        getPolicyLbServerSSLBindingSchema(),
+				Optional:    true,
+				MaxItems:    1,
+			},
+		},
+	}
+}
+
+func policyLBHTTPSMonitorProfilePatch(id string, d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	client := infra.NewDefaultLbMonitorProfilesClient(connector)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	fallCount := int64(d.Get("fall_count").(int))
+	interval := int64(d.Get("interval").(int))
+	riseCount := int64(d.Get("rise_count").(int))
+	timeout := int64(d.Get("timeout").(int))
+	requestBody := d.Get("request_body").(string)
+	requestMethod := d.Get("request_method").(string)
+	requestURL := d.Get("request_url").(string)
+	requestVersion := d.Get("request_version").(string)
+	responseBody := d.Get("response_body").(string)
+
+	obj := model.LBHttpsMonitorProfile{
+		DisplayName:             &displayName,
+		Description:             &description,
+		Tags:                    tags,
+		FallCount:               &fallCount,
+		Interval:                &interval,
+		MonitorPort:             getPolicyLbMonitorPortFromSchema(d),
+		RiseCount:               &riseCount,
+		Timeout:                 &timeout,
+		RequestBody:             &requestBody,
+		RequestHeaders:          getPolicyLbHTTPHeaderFromSchema(d, "request_header"),
+		RequestMethod:           &requestMethod,
+		RequestUrl:              &requestURL,
+		RequestVersion:          &requestVersion,
+		ResponseBody:            &responseBody,
+		ResponseStatusCodes:     getPolicyLbResponseStatusCodesFromSchema(d),
+		ServerSslProfileBinding: getPolicyServerSSLBindingFromSchema(d),
+		ResourceType:            model.LBMonitorProfile_RESOURCE_TYPE_LBHTTPSMONITORPROFILE,
+	}
+
+	dataValue, err := policyLbProfileToStructValue(obj, model.LBHttpsMonitorProfileBindingType())
+	if err != nil {
+		return err
+	}
+
+	return client.Patch(id, dataValue)
+}
+
+func resourceNsxtPolicyLBHTTPSMonitorProfileCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyLBMonitorProfileExists)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating LBHttpsMonitorProfile with ID %s", id)
+	err = policyLBHTTPSMonitorProfilePatch(id, d, m)
+	if err != nil {
+		return handleCreateError("LBHttpsMonitorProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyLBHTTPSMonitorProfileRead(d, m)
+}
+
+func resourceNsxtPolicyLBHTTPSMonitorProfileRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewDefaultLbMonitorProfilesClient(connector)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBHttpsMonitorProfile ID")
+	}
+
+	dataValue, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "LBHttpsMonitorProfile", id, err)
+	}
+
+	profile, err := policyLbProfileFromStructValue(dataValue, model.LBHttpsMonitorProfileBindingType())
+	if err != nil {
+		return err
+	}
+	obj := profile.(model.LBHttpsMonitorProfile)
+	if obj.ResourceType != model.LBMonitorProfile_RESOURCE_TYPE_LBHTTPSMONITORPROFILE {
+		return fmt.Errorf("LB Monitor Profile %s is of type %s", id, obj.ResourceType)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("fall_count", obj.FallCount)
+	d.Set("interval", obj.Interval)
+	setPolicyLbMonitorPortInSchema(d, obj.MonitorPort)
+	d.Set("rise_count", obj.RiseCount)
+	d.Set("timeout", obj.Timeout)
+	d.Set("request_body", obj.RequestBody)
+	setPolicyLbHTTPHeaderInSchema(d, "request_header", obj.RequestHeaders)
+	d.Set("request_method", obj.RequestMethod)
+	d.Set("request_url", obj.RequestUrl)
+	d.Set("request_version", obj.RequestVersion)
+	d.Set("response_body", obj.ResponseBody)
+	d.Set("response_status_codes", obj.ResponseStatusCodes)
+	setPolicyServerSSLBindingInSchema(d, obj.ServerSslProfileBinding)
+
+	return nil
+}
+
+func resourceNsxtPolicyLBHTTPSMonitorProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBHttpsMonitorProfile ID")
+	}
+
+	log.Printf("[INFO] Updating LBHttpsMonitorProfile with ID %s", id)
+	err := policyLBHTTPSMonitorProfilePatch(id, d, m)
+	if err != nil {
+		return handleUpdateError("LBHttpsMonitorProfile", id, err)
+	}
+
+	return resourceNsxtPolicyLBHTTPSMonitorProfileRead(d, m)
+}
@@ -0,0 +1,213 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyLBHTTPSMonitorProfileCreateAttributes = map[string]string{
+	"display_name":   getAccTestResourceName(),
+	"description":    "terraform created",
+	"fall_count":     "2",
+	"interval":       "4",
+	"monitor_port":   "8443",
+	"rise_count":     "5",
+	"timeout":        "10",
+	"request_method": "HEAD",
+	"request_url":    "/health",
+}
+
+var accTestPolicyLBHTTPSMonitorProfileUpdateAttributes = map[string]string{
+	"display_name":   getAccTestResourceName(),
+	"description":    "terraform updated",
+	"fall_count":     "3",
+	"interval":       "5",
+	"monitor_port":   "9443",
+	"rise_count":     "3",
+	"timeout":        "15",
+	"request_method": "GET",
+	"request_url":    "/healthz",
+}
+
+func TestAccResourceNsxtPolicyLBHTTPSMonitorProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_lb_https_monitor_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBHTTPSMonitorProfileCheckDestroy(state, accTestPolicyLBHTTPSMonitorProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBHTTPSMonitorProfileTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBHTTPSMonitorProfileExists(accTestPolicyLBHTTPSMonitorProfileCreateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyLBHTTPSMonitorProfileCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyLBHTTPSMonitorProfileCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "fall_count", accTestPolicyLBHTTPSMonitorProfileCreateAttributes["fall_count"]),
+					resource.TestCheckResourceAttr(testResourceName, "interval", accTestPolicyLBHTTPSMonitorProfileCreateAttributes["interval"]),
+					resource.TestCheckResourceAttr(testResourceName, "monitor_port", accTestPolicyLBHTTPSMonitorProfileCreateAttributes["monitor_port"]),
+					resource.TestCheckResourceAttr(testResourceName, "rise_count", accTestPolicyLBHTTPSMonitorProfileCreateAttributes["rise_count"]),
+					resource.TestCheckResourceAttr(testResourceName, "timeout", accTestPolicyLBHTTPSMonitorProfileCreateAttributes["timeout"]),
+					resource.TestCheckResourceAttr(testResourceName, "request_method", accTestPolicyLBHTTPSMonitorProfileCreateAttributes["request_method"]),
+					resource.TestCheckResourceAttr(testResourceName, "request_url", accTestPolicyLBHTTPSMonitorProfileCreateAttributes["request_url"]),
+					resource.TestCheckResourceAttr(testResourceName, "server_ssl.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "server_ssl.0.server_auth", "IGNORE"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLBHTTPSMonitorProfileTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBHTTPSMonitorProfileExists(accTestPolicyLBHTTPSMonitorProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyLBHTTPSMonitorProfileUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyLBHTTPSMonitorProfileUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "fall_count", accTestPolicyLBHTTPSMonitorProfileUpdateAttributes["fall_count"]),
+					resource.TestCheckResourceAttr(testResourceName, "interval", accTestPolicyLBHTTPSMonitorProfileUpdateAttributes["interval"]),
+					resource.TestCheckResourceAttr(testResourceName, "monitor_port", accTestPolicyLBHTTPSMonitorProfileUpdateAttributes["monitor_port"]),
+					resource.TestCheckResourceAttr(testResourceName, "rise_count", accTestPolicyLBHTTPSMonitorProfileUpdateAttributes["rise_count"]),
+					resource.TestCheckResourceAttr(testResourceName, "timeout", accTestPolicyLBHTTPSMonitorProfileUpdateAttributes["timeout"]),
+					resource.TestCheckResourceAttr(testResourceName, "request_method", accTestPolicyLBHTTPSMonitorProfileUpdateAttributes["request_method"]),
+					resource.TestCheckResourceAttr(testResourceName, "request_url", accTestPolicyLBHTTPSMonitorProfileUpdateAttributes["request_url"]),
+					resource.TestCheckResourceAttr(testResourceName, "server_ssl.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "server_ssl.0.certificate_chain_depth", "2"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLBHTTPSMonitorProfileMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBHTTPSMonitorProfileExists(accTestPolicyLBHTTPSMonitorProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyLBHTTPSMonitorProfile_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_lb_https_monitor_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBHTTPSMonitorProfileCheckDestroy(state, accTestPolicyLBHTTPSMonitorProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBHTTPSMonitorProfileMinimalistic(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyLBHTTPSMonitorProfileExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy LB HTTPS Monitor Profile resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy LB HTTPS Monitor Profile resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyLBMonitorProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy LB HTTPS Monitor Profile %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyLBHTTPSMonitorProfileCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_lb_https_monitor_profile" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyLBMonitorProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err == nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy LB HTTPS Monitor Profile %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyLBHTTPSMonitorProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	var tags string
+	if createFlow {
+		attrMap = accTestPolicyLBHTTPSMonitorProfileCreateAttributes
+		tags = `
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }`
+	} else {
+		attrMap = accTestPolicyLBHTTPSMonitorProfileUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_lb_https_monitor_profile" "test" {
+  display_name   = "%s"
+  description    = "%s"
+  fall_count     = %s
+  interval       = %s
+  monitor_port   = "%s"
+  rise_count     = %s
+  timeout        = %s
+  request_method = "%s"
+  request_url    = "%s"
+
+  server_ssl {
+    server_auth             = "IGNORE"
+    certificate_chain_depth = 2
+  }
+%s
+}`, attrMap["display_name"], attrMap["description"], attrMap["fall_count"], attrMap["interval"], attrMap["monitor_port"], attrMap["rise_count"], attrMap["timeout"], attrMap["request_method"], attrMap["request_url"], tags)
+}
+
+func testAccNsxtPolicyLBHTTPSMonitorProfileMinimalistic() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_lb_https_monitor_profile" "test" {
+  display_name = "%s"
+}`, accTestPolicyLBHTTPSMonitorProfileUpdateAttributes["display_name"])
+}
@@ -0,0 +1,168 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyLBIcmpMonitorProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyLBIcmpMonitorProfileCreate,
+		Read:   resourceNsxtPolicyLBIcmpMonitorProfileRead,
+		Update: resourceNsxtPolicyLBIcmpMonitorProfileUpdate,
+		Delete: resourceNsxtPolicyLBMonitorProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: nsxVersionCustomizeDiff("3.0.0"),
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"fall_count":   getLbMonitorFallCountSchema(),
+			"interval":     getLbMonitorIntervalSchema(),
+			"monitor_port": getLbMonitorPortSchema(),
+			"rise_count":   getLbMonitorRiseCountSchema(),
+			"timeout":      getLbMonitorTimeoutSchema(),
+			"data_length": {
+				Type:         schema.TypeInt,
+				Description:  "The data size (in bytes) of the ICMP healthcheck packet",
+				Optional:     true,
+				Default:      56,
+				ValidateFunc: validation.IntBetween(0, 65507),
+			},
+		},
+	}
+}
+
+func policyLBIcmpMonitorProfilePatch(id string, d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	client := infra.NewDefaultLbMonitorProfilesClient(connector)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	fallCount := int64(d.Get("fall_count").(int))
+	interval := int64(d.Get("interval").(int))
+	riseCount := int64(d.Get("rise_count").(int))
+	timeout := int64(d.Get("timeout").(int))
+	dataLength := int64(d.Get("data_length").(int))
+
+	obj := model.LBIcmpMonitorProfile{
+		DisplayName:  &displayName,
+		Description:  &description,
+		Tags:         tags,
+		FallCount:    &fallCount,
+		Interval:     &interval,
+		MonitorPort:  getPolicyLbMonitorPortFromSchema(d),
+		RiseCount:    &riseCount,
+		Timeout:      &timeout,
+		DataLength:   &dataLength,
+		ResourceType: model.LBMonitorProfile_RESOURCE_TYPE_LBICMPMONITORPROFILE,
+	}
+
+	dataValue, err := policyLbProfileToStructValue(obj, model.LBIcmpMonitorProfileBindingType())
+	if err != nil {
+		return err
+	}
+
+	return client.Patch(id, dataValue)
+}
+
+func resourceNsxtPolicyLBIcmpMonitorProfileCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyLBMonitorProfileExists)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating LBIcmpMonitorProfile with ID %s", id)
+	err = policyLBIcmpMonitorProfilePatch(id, d, m)
+	if err != nil {
+		return handleCreateError("LBIcmpMonitorProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyLBIcmpMonitorProfileRead(d, m)
+}
+
+func resourceNsxtPolicyLBIcmpMonitorProfileRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewDefaultLbMonitorProfilesClient(connector)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBIcmpMonitorProfile ID")
+	}
+
+	dataValue, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "LBIcmpMonitorProfile", id, err)
+	}
+
+	profile, err := policyLbProfileFromStructValue(dataValue, model.LBIcmpMonitorProfileBindingType())
+	if err != nil {
+		return err
+	}
+	obj := profile.(model.LBIcmpMonitorProfile)
+	if obj.ResourceType != model.LBMonitorProfile_RESOURCE_TYPE_LBICMPMONITORPROFILE {
+		return fmt.Errorf("LB Monitor Profile %s is of type %s", id, obj.ResourceType)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("fall_count", obj.FallCount)
+	d.Set("interval", obj.Interval)
+	setPolicyLbMonitorPortInSchema(d, obj.MonitorPort)
+	d.Set("rise_count", obj.RiseCount)
+	d.Set("timeout", obj.Timeout)
+	d.Set("data_length", obj.DataLength)
+
+	return nil
+}
+
+func resourceNsxtPolicyLBIcmpMonitorProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBIcmpMonitorProfile ID")
+	}
+
+	log.Printf("[INFO] Updating LBIcmpMonitorProfile with ID %s", id)
+	err := policyLBIcmpMonitorProfilePatch(id, d, m)
+	if err != nil {
+		return handleUpdateError("LBIcmpMonitorProfile", id, err)
+	}
+
+	return resourceNsxtPolicyLBIcmpMonitorProfileRead(d, m)
+}
@@ -0,0 +1,194 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyLBIcmpMonitorProfileCreateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform created",
+	"fall_count":   "2",
+	"interval":     "4",
+	"rise_count":   "5",
+	"timeout":      "10",
+	"data_length":  "100",
+}
+
+var accTestPolicyLBIcmpMonitorProfileUpdateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform updated",
+	"fall_count":   "3",
+	"interval":     "5",
+	"rise_count":   "3",
+	"timeout":      "15",
+	"data_length":  "200",
+}
+
+func TestAccResourceNsxtPolicyLBIcmpMonitorProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_lb_icmp_monitor_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBIcmpMonitorProfileCheckDestroy(state, accTestPolicyLBIcmpMonitorProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBIcmpMonitorProfileTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBIcmpMonitorProfileExists(accTestPolicyLBIcmpMonitorProfileCreateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyLBIcmpMonitorProfileCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyLBIcmpMonitorProfileCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "fall_count", accTestPolicyLBIcmpMonitorProfileCreateAttributes["fall_count"]),
+					resource.TestCheckResourceAttr(testResourceName, "interval", accTestPolicyLBIcmpMonitorProfileCreateAttributes["interval"]),
+					resource.TestCheckResourceAttr(testResourceName, "rise_count", accTestPolicyLBIcmpMonitorProfileCreateAttributes["rise_count"]),
+					resource.TestCheckResourceAttr(testResourceName, "timeout", accTestPolicyLBIcmpMonitorProfileCreateAttributes["timeout"]),
+					resource.TestCheckResourceAttr(testResourceName, "data_length", accTestPolicyLBIcmpMonitorProfileCreateAttributes["data_length"]),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLBIcmpMonitorProfileTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBIcmpMonitorProfileExists(accTestPolicyLBIcmpMonitorProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyLBIcmpMonitorProfileUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyLBIcmpMonitorProfileUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "fall_count", accTestPolicyLBIcmpMonitorProfileUpdateAttributes["fall_count"]),
+					resource.TestCheckResourceAttr(testResourceName, "interval", accTestPolicyLBIcmpMonitorProfileUpdateAttributes["interval"]),
+					resource.TestCheckResourceAttr(testResourceName, "rise_count", accTestPolicyLBIcmpMonitorProfileUpdateAttributes["rise_count"]),
+					resource.TestCheckResourceAttr(testResourceName, "timeout", accTestPolicyLBIcmpMonitorProfileUpdateAttributes["timeout"]),
+					resource.TestCheckResourceAttr(testResourceName, "data_length", accTestPolicyLBIcmpMonitorProfileUpdateAttributes["data_length"]),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLBIcmpMonitorProfileMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBIcmpMonitorProfileExists(accTestPolicyLBIcmpMonitorProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyLBIcmpMonitorProfile_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_lb_icmp_monitor_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBIcmpMonitorProfileCheckDestroy(state, accTestPolicyLBIcmpMonitorProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBIcmpMonitorProfileMinimalistic(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyLBIcmpMonitorProfileExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy LB ICMP Monitor Profile resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy LB ICMP Monitor Profile resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyLBMonitorProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy LB ICMP Monitor Profile %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyLBIcmpMonitorProfileCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_lb_icmp_monitor_profile" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyLBMonitorProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err == nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy LB ICMP Monitor Profile %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyLBIcmpMonitorProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	var tags string
+	if createFlow {
+		attrMap = accTestPolicyLBIcmpMonitorProfileCreateAttributes
+		tags = `
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }`
+	} else {
+		attrMap = accTestPolicyLBIcmpMonitorProfileUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_lb_icmp_monitor_profile" "test" {
+  display_name = "%s"
+  description  = "%s"
+  fall_count   = %s
+  interval     = %s
+  rise_count   = %s
+  timeout      = %s
+  data_length  = %s
+%s
+}`, attrMap["display_name"], attrMap["description"], attrMap["fall_count"], attrMap["interval"], attrMap["rise_count"], attrMap["timeout"], attrMap["data_length"], tags)
+}
+
+func testAccNsxtPolicyLBIcmpMonitorProfileMinimalistic() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_lb_icmp_monitor_profile" "test" {
+  display_name = "%s"
+}`, accTestPolicyLBIcmpMonitorProfileUpdateAttributes["display_name"])
+}
@@ -0,0 +1,156 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyLBPassiveMonitorProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyLBPassiveMonitorProfileCreate,
+		Read:   resourceNsxtPolicyLBPassiveMonitorProfileRead,
+		Update: resourceNsxtPolicyLBPassiveMonitorProfileUpdate,
+		Delete: resourceNsxtPolicyLBMonitorProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: nsxVersionCustomizeDiff("3.0.0"),
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"max_fails": {
+				Type:        schema.TypeInt,
+				Description: "When the consecutive failures reach this value, then the member is considered temporarily unavailable for a configurable period",
+				Optional:    true,
+				Default:     5,
+			},
+			"timeout": {
+				Type:        schema.TypeInt,
+				Description: "After this timeout period, the member is tried again for a new connection to see if it is available",
+				Optional:    true,
+				Default:     5,
+			},
+		},
+	}
+}
+
+func policyLBPassiveMonitorProfilePatch(id string, d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	client := infra.NewDefaultLbMonitorProfilesClient(connector)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	maxFails := int64(d.Get("max_fails").(int))
+	timeout := int64(d.Get("timeout").(int))
+
+	obj := model.LBPassiveMonitorProfile{
+		DisplayName:  &displayName,
+		Description:  &description,
+		Tags:         tags,
+		MaxFails:     &maxFails,
+		Timeout:      &timeout,
+		ResourceType: model.LBMonitorProfile_RESOURCE_TYPE_LBPASSIVEMONITORPROFILE,
+	}
+
+	dataValue, err := policyLbProfileToStructValue(obj, model.LBPassiveMonitorProfileBindingType())
+	if err != nil {
+		return err
+	}
+
+	return client.Patch(id, dataValue)
+}
+
+func resourceNsxtPolicyLBPassiveMonitorProfileCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyLBMonitorProfileExists)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating LBPassiveMonitorProfile with ID %s", id)
+	err = policyLBPassiveMonitorProfilePatch(id, d, m)
+	if err != nil {
+		return handleCreateError("LBPassiveMonitorProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyLBPassiveMonitorProfileRead(d, m)
+}
+
+func resourceNsxtPolicyLBPassiveMonitorProfileRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewDefaultLbMonitorProfilesClient(connector)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBPassiveMonitorProfile ID")
+	}
+
+	dataValue, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "LBPassiveMonitorProfile", id, err)
+	}
+
+	profile, err := policyLbProfileFromStructValue(dataValue, model.LBPassiveMonitorProfileBindingType())
+	if err != nil {
+		return err
+	}
+	obj := profile.(model.LBPassiveMonitorProfile)
+	if obj.ResourceType != model.LBMonitorProfile_RESOURCE_TYPE_LBPASSIVEMONITORPROFILE {
+		return fmt.Errorf("LB Monitor Profile %s is of type %s", id, obj.ResourceType)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("max_fails", obj.MaxFails)
+	d.Set("timeout", obj.Timeout)
+
+	return nil
+}
+
+func resourceNsxtPolicyLBPassiveMonitorProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBPassiveMonitorProfile ID")
+	}
+
+	log.Printf("[INFO] Updating LBPassiveMonitorProfile with ID %s", id)
+	err := policyLBPassiveMonitorProfilePatch(id, d, m)
+	if err != nil {
+		return handleUpdateError("LBPassiveMonitorProfile", id, err)
+	}
+
+	return resourceNsxtPolicyLBPassiveMonitorProfileRead(d, m)
+}
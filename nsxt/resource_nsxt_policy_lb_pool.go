@@ -316,7 +316,7 @@ func getPolicyPoolSnatFromSchema(d *schema.ResourceData) (*data.StructValue, err
 				// cidr format
 				prefix, err := strconv.Atoi(tokens[1])
 				if err != nil {
-					return nil, fmt.Errorf("Failed to convert snat address prefix: %s", err)
+					return nil, fmt.Errorf("Failed to convert snat address prefix: %w", err)
 				}
 				prefix64 := int64(prefix)
 				element := model.LBSnatIpElement{
@@ -418,13 +418,13 @@ func resourceNsxtPolicyLBPoolExists(id string, connector *client.RestConnector,
 }
 
 func resourceNsxtPolicyLBPoolCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
 	connector := getPolicyConnector(m)
 	client := infra.NewDefaultLbPoolsClient(connector)
 
-	if client == nil {
-		return policyResourceNotSupportedError()
-	}
-
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyLBPoolExists)
 	if err != nil {
@@ -476,13 +476,13 @@ func resourceNsxtPolicyLBPoolCreate(d *schema.ResourceData, m interface{}) error
 }
 
 func resourceNsxtPolicyLBPoolRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
 	connector := getPolicyConnector(m)
 	client := infra.NewDefaultLbPoolsClient(connector)
 
-	if client == nil {
-		return policyResourceNotSupportedError()
-	}
-
 	id := d.Id()
 	if id == "" {
 		return fmt.Errorf("Error obtaining LBPool ID")
@@ -536,11 +536,12 @@ func resourceNsxtPolicyLBPoolRead(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNsxtPolicyLBPoolUpdate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
 	connector := getPolicyConnector(m)
 	client := infra.NewDefaultLbPoolsClient(connector)
-	if client == nil {
-		return policyResourceNotSupportedError()
-	}
 
 	id := d.Id()
 	if id == "" {
@@ -597,11 +598,12 @@ func resourceNsxtPolicyLBPoolDelete(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error obtaining LBPool ID")
 	}
 
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
 	connector := getPolicyConnector(m)
 	client := infra.NewDefaultLbPoolsClient(connector)
-	if client == nil {
-		return policyResourceNotSupportedError()
-	}
 
 	force := true
 	err := client.Delete(id, &force)
@@ -0,0 +1,187 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyLBServerSslProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyLBServerSslProfileCreate,
+		Read:   resourceNsxtPolicyLBServerSslProfileRead,
+		Update: resourceNsxtPolicyLBServerSslProfileUpdate,
+		Delete: resourceNsxtPolicyLBServerSslProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: nsxVersionCustomizeDiff("3.0.0"),
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":             getNsxIDSchema(),
+			"path":               getPathSchema(),
+			"display_name":       getDisplayNameSchema(),
+			"description":        getDescriptionSchema(),
+			"revision":           getRevisionSchema(),
+			"tag":                getTagsSchema(),
+			"cipher_group_label": getPolicyLbSslCipherGroupLabelSchema(),
+			"ciphers":            getSSLCiphersSchema(),
+			"is_fips":            getPolicyLbSslIsFipsSchema(),
+			"is_secure":          getIsSecureSchema(),
+			"protocols":          getSSLProtocolsSchema(),
+			"session_cache_enabled": {
+				Type:        schema.TypeBool,
+				Description: "Reuse previously negotiated security parameters during handshake",
+				Optional:    true,
+				Default:     true,
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyLBServerSslProfileExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	client := infra.NewDefaultLbServerSslProfilesClient(connector)
+
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving LBServerSslProfile", err)
+}
+
+func policyLBServerSslProfilePatch(id string, d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	client := infra.NewDefaultLbServerSslProfilesClient(connector)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	cipherGroupLabel := d.Get("cipher_group_label").(string)
+	sessionCacheEnabled := d.Get("session_cache_enabled").(bool)
+
+	obj := model.LBServerSslProfile{
+		DisplayName:         &displayName,
+		Description:         &description,
+		Tags:                tags,
+		CipherGroupLabel:    &cipherGroupLabel,
+		Protocols:           getStringListFromSchemaSet(d, "protocols"),
+		SessionCacheEnabled: &sessionCacheEnabled,
+	}
+
+	// Ciphers are determined by NSX unless cipher group is CUSTOM
+	if cipherGroupLabel == model.LBServerSslProfile_CIPHER_GROUP_LABEL_CUSTOM {
+		obj.Ciphers = getStringListFromSchemaSet(d, "ciphers")
+	}
+
+	return client.Patch(id, obj)
+}
+
+func resourceNsxtPolicyLBServerSslProfileCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyLBServerSslProfileExists)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating LBServerSslProfile with ID %s", id)
+	err = policyLBServerSslProfilePatch(id, d, m)
+	if err != nil {
+		return handleCreateError("LBServerSslProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyLBServerSslProfileRead(d, m)
+}
+
+func resourceNsxtPolicyLBServerSslProfileRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewDefaultLbServerSslProfilesClient(connector)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBServerSslProfile ID")
+	}
+
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "LBServerSslProfile", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("cipher_group_label", obj.CipherGroupLabel)
+	d.Set("ciphers", obj.Ciphers)
+	d.Set("is_fips", obj.IsFips)
+	d.Set("is_secure", obj.IsSecure)
+	d.Set("protocols", obj.Protocols)
+	d.Set("session_cache_enabled", obj.SessionCacheEnabled)
+
+	return nil
+}
+
+func resourceNsxtPolicyLBServerSslProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBServerSslProfile ID")
+	}
+
+	log.Printf("[INFO] Updating LBServerSslProfile with ID %s", id)
+	err := policyLBServerSslProfilePatch(id, d, m)
+	if err != nil {
+		return handleUpdateError("LBServerSslProfile", id, err)
+	}
+
+	return resourceNsxtPolicyLBServerSslProfileRead(d, m)
+}
+
+func resourceNsxtPolicyLBServerSslProfileDelete(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBServerSslProfile ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewDefaultLbServerSslProfilesClient(connector)
+	force := true
+	err := client.Delete(id, &force)
+	if err != nil {
+		return handleDeleteError("LBServerSslProfile", id, err)
+	}
+
+	return nil
+}
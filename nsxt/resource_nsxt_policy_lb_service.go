@@ -90,13 +90,13 @@ func resourceNsxtPolicyLBServiceExists(id string, connector *client.RestConnecto
 }
 
 func resourceNsxtPolicyLBServiceCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
 	connector := getPolicyConnector(m)
 	client := infra.NewDefaultLbServicesClient(connector)
 
-	if client == nil {
-		return policyResourceNotSupportedError()
-	}
-
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyLBServiceExists)
 	if err != nil {
@@ -110,7 +110,7 @@ func resourceNsxtPolicyLBServiceCreate(d *schema.ResourceData, m interface{}) er
 	enabled := d.Get("enabled").(bool)
 	errorLogLevel := d.Get("error_log_level").(string)
 	size := d.Get("size").(string)
-	if size == "XLARGE" && nsxVersionLower("3.0.0") {
+	if size == "XLARGE" && nsxVersionLower(m, "3.0.0") {
 		return fmt.Errorf("XLARGE size is not supported before NSX version 3.0.0")
 	}
 
@@ -138,13 +138,13 @@ func resourceNsxtPolicyLBServiceCreate(d *schema.ResourceData, m interface{}) er
 }
 
 func resourceNsxtPolicyLBServiceRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
 	connector := getPolicyConnector(m)
 	client := infra.NewDefaultLbServicesClient(connector)
 
-	if client == nil {
-		return policyResourceNotSupportedError()
-	}
-
 	id := d.Id()
 	if id == "" {
 		return fmt.Errorf("Error obtaining LBService ID")
@@ -171,11 +171,12 @@ func resourceNsxtPolicyLBServiceRead(d *schema.ResourceData, m interface{}) erro
 }
 
 func resourceNsxtPolicyLBServiceUpdate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
 	connector := getPolicyConnector(m)
 	client := infra.NewDefaultLbServicesClient(connector)
-	if client == nil {
-		return policyResourceNotSupportedError()
-	}
 
 	id := d.Id()
 	if id == "" {
@@ -191,7 +192,7 @@ func resourceNsxtPolicyLBServiceUpdate(d *schema.ResourceData, m interface{}) er
 	enabled := d.Get("enabled").(bool)
 	errorLogLevel := d.Get("error_log_level").(string)
 	size := d.Get("size").(string)
-	if size == "XLARGE" && nsxVersionLower("3.0.0") {
+	if size == "XLARGE" && nsxVersionLower(m, "3.0.0") {
 		return fmt.Errorf("XLARGE size is not supported before NSX version 3.0.0")
 	}
 
@@ -220,11 +221,12 @@ func resourceNsxtPolicyLBServiceDelete(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error obtaining LBService ID")
 	}
 
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
 	connector := getPolicyConnector(m)
 	client := infra.NewDefaultLbServicesClient(connector)
-	if client == nil {
-		return policyResourceNotSupportedError()
-	}
 
 	force := true
 	err := client.Delete(id, &force)
@@ -0,0 +1,182 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var lbSourceIPPersistencePurgeValues = []string{
+	model.LBSourceIpPersistenceProfile_PURGE_NO_PURGE,
+	model.LBSourceIpPersistenceProfile_PURGE_FULL,
+}
+
+func resourceNsxtPolicyLBSourceIPPersistenceProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyLBSourceIPPersistenceProfileCreate,
+		Read:   resourceNsxtPolicyLBSourceIPPersistenceProfileRead,
+		Update: resourceNsxtPolicyLBSourceIPPersistenceProfileUpdate,
+		Delete: resourceNsxtPolicyLBPersistenceProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: nsxVersionCustomizeDiff("3.0.0"),
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"ha_persistence_mirroring_enabled": {
+				Type:        schema.TypeBool,
+				Description: "If true, persistence entries will be synchronized to the HA peer",
+				Optional:    true,
+				Default:     false,
+			},
+			"persistence_shared": {
+				Type:        schema.TypeBool,
+				Description: "If true, persistence entries are shared among virtual servers referring to this profile",
+				Optional:    true,
+				Default:     false,
+			},
+			"purge": {
+				Type:         schema.TypeString,
+				Description:  "Persistence purge setting",
+				Optional:     true,
+				Default:      model.LBSourceIpPersistenceProfile_PURGE_FULL,
+				ValidateFunc: validation.StringInSlice(lbSourceIPPersistencePurgeValues, false),
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Description:  "Persistence expiration time in seconds, counted from the time all the connections are completed",
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func policyLBSourceIPPersistenceProfilePatch(id string, d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	client := infra.NewDefaultLbPersistenceProfilesClient(connector)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	haPersistenceMirroringEnabled := d.Get("ha_persistence_mirroring_enabled").(bool)
+	persistenceShared := d.Get("persistence_shared").(bool)
+	purge := d.Get("purge").(string)
+	timeout := int64(d.Get("timeout").(int))
+
+	obj := model.LBSourceIpPersistenceProfile{
+		DisplayName:                   &displayName,
+		Description:                   &description,
+		Tags:                          tags,
+		HaPersistenceMirroringEnabled: &haPersistenceMirroringEnabled,
+		PersistenceShared:             &persistenceShared,
+		Purge:                         &purge,
+		Timeout:                       &timeout,
+		ResourceType:                  model.LBPersistenceProfile_RESOURCE_TYPE_LBSOURCEIPPERSISTENCEPROFILE,
+	}
+
+	dataValue, err := policyLbProfileToStructValue(obj, model.LBSourceIpPersistenceProfileBindingType())
+	if err != nil {
+		return err
+	}
+
+	return client.Patch(id, dataValue)
+}
+
+func resourceNsxtPolicyLBSourceIPPersistenceProfileCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyLBPersistenceProfileExists)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating LBSourceIpPersistenceProfile with ID %s", id)
+	err = policyLBSourceIPPersistenceProfilePatch(id, d, m)
+	if err != nil {
+		return handleCreateError("LBSourceIpPersistenceProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyLBSourceIPPersistenceProfileRead(d, m)
+}
+
+func resourceNsxtPolicyLBSourceIPPersistenceProfileRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewDefaultLbPersistenceProfilesClient(connector)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBSourceIpPersistenceProfile ID")
+	}
+
+	dataValue, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "LBSourceIpPersistenceProfile", id, err)
+	}
+
+	profile, err := policyLbProfileFromStructValue(dataValue, model.LBSourceIpPersistenceProfileBindingType())
+	if err != nil {
+		return err
+	}
+	obj := profile.(model.LBSourceIpPersistenceProfile)
+	if obj.ResourceType != model.LBPersistenceProfile_RESOURCE_TYPE_LBSOURCEIPPERSISTENCEPROFILE {
+		return fmt.Errorf("LB Persistence Profile %s is of type %s", id, obj.ResourceType)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("ha_persistence_mirroring_enabled", obj.HaPersistenceMirroringEnabled)
+	d.Set("persistence_shared", obj.PersistenceShared)
+	d.Set("purge", obj.Purge)
+	d.Set("timeout", obj.Timeout)
+
+	return nil
+}
+
+func resourceNsxtPolicyLBSourceIPPersistenceProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBSourceIpPersistenceProfile ID")
+	}
+
+	log.Printf("[INFO] Updating LBSourceIpPersistenceProfile with ID %s", id)
+	err := policyLBSourceIPPersistenceProfilePatch(id, d, m)
+	if err != nil {
+		return handleUpdateError("LBSourceIpPersistenceProfile", id, err)
+	}
+
+	return resourceNsxtPolicyLBSourceIPPersistenceProfileRead(d, m)
+}
@@ -0,0 +1,157 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyLBTCPMonitorProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyLBTCPMonitorProfileCreate,
+		Read:   resourceNsxtPolicyLBTCPMonitorProfileRead,
+		Update: resourceNsxtPolicyLBTCPMonitorProfileUpdate,
+		Delete: resourceNsxtPolicyLBMonitorProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: nsxVersionCustomizeDiff("3.0.0"),
+
+		Schema: getPolicyLbL4MonitorProfileSchema("tcp"),
+	}
+}
+
+func policyLBTCPMonitorProfilePatch(id string, d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	client := infra.NewDefaultLbMonitorProfilesClient(connector)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	fallCount := int64(d.Get("fall_count").(int))
+	interval := int64(d.Get("interval").(int))
+	riseCount := int64(d.Get("rise_count").(int))
+	timeout := int64(d.Get("timeout").(int))
+
+	obj := model.LBTcpMonitorProfile{
+		DisplayName:  &displayName,
+		Description:  &description,
+		Tags:         tags,
+		FallCount:    &fallCount,
+		Interval:     &interval,
+		MonitorPort:  getPolicyLbMonitorPortFromSchema(d),
+		RiseCount:    &riseCount,
+		Timeout:      &timeout,
+		ResourceType: model.LBMonitorProfile_RESOURCE_TYPE_LBTCPMONITORPROFILE,
+	}
+
+	receive := d.Get("receive").(string)
+	if receive != "" {
+		obj.Receive = &receive
+	}
+
+	send := d.Get("send").(string)
+	if send != "" {
+		obj.Send = &send
+	}
+
+	dataValue, err := policyLbProfileToStructValue(obj, model.LBTcpMonitorProfileBindingType())
+	if err != nil {
+		return err
+	}
+
+	return client.Patch(id, dataValue)
+}
+
+func resourceNsxtPolicyLBTCPMonitorProfileCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyLBMonitorProfileExists)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating LBTcpMonitorProfile with ID %s", id)
+	err = policyLBTCPMonitorProfilePatch(id, d, m)
+	if err != nil {
+		return handleCreateError("LBTcpMonitorProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyLBTCPMonitorProfileRead(d, m)
+}
+
+func resourceNsxtPolicyLBTCPMonitorProfileRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewDefaultLbMonitorProfilesClient(connector)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBTcpMonitorProfile ID")
+	}
+
+	dataValue, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "LBTcpMonitorProfile", id, err)
+	}
+
+	profile, err := policyLbProfileFromStructValue(dataValue, model.LBTcpMonitorProfileBindingType())
+	if err != nil {
+		return err
+	}
+	obj := profile.(model.LBTcpMonitorProfile)
+	if obj.ResourceType != model.LBMonitorProfile_RESOURCE_TYPE_LBTCPMONITORPROFILE {
+		return fmt.Errorf("LB Monitor Profile %s is of type %s", id, obj.ResourceType)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("fall_count", obj.FallCount)
+	d.Set("interval", obj.Interval)
+	setPolicyLbMonitorPortInSchema(d, obj.MonitorPort)
+	d.Set("rise_count", obj.RiseCount)
+	d.Set("timeout", obj.Timeout)
+	d.Set("receive", obj.Receive)
+	d.Set("send", obj.Send)
+
+	return nil
+}
+
+func resourceNsxtPolicyLBTCPMonitorProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBTcpMonitorProfile ID")
+	}
+
+	log.Printf("[INFO] Updating LBTcpMonitorProfile with ID %s", id)
+	err := policyLBTCPMonitorProfilePatch(id, d, m)
+	if err != nil {
+		return handleUpdateError("LBTcpMonitorProfile", id, err)
+	}
+
+	return resourceNsxtPolicyLBTCPMonitorProfileRead(d, m)
+}
@@ -0,0 +1,204 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyLBTCPMonitorProfileCreateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform created",
+	"fall_count":   "2",
+	"interval":     "4",
+	"monitor_port": "7001",
+	"rise_count":   "5",
+	"timeout":      "10",
+	"send":         "ping",
+	"receive":      "pong",
+}
+
+var accTestPolicyLBTCPMonitorProfileUpdateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform updated",
+	"fall_count":   "3",
+	"interval":     "5",
+	"monitor_port": "7002",
+	"rise_count":   "3",
+	"timeout":      "15",
+	"send":         "hello",
+	"receive":      "world",
+}
+
+func TestAccResourceNsxtPolicyLBTCPMonitorProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_lb_tcp_monitor_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBTCPMonitorProfileCheckDestroy(state, accTestPolicyLBTCPMonitorProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBTCPMonitorProfileTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBTCPMonitorProfileExists(accTestPolicyLBTCPMonitorProfileCreateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyLBTCPMonitorProfileCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyLBTCPMonitorProfileCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "fall_count", accTestPolicyLBTCPMonitorProfileCreateAttributes["fall_count"]),
+					resource.TestCheckResourceAttr(testResourceName, "interval", accTestPolicyLBTCPMonitorProfileCreateAttributes["interval"]),
+					resource.TestCheckResourceAttr(testResourceName, "monitor_port", accTestPolicyLBTCPMonitorProfileCreateAttributes["monitor_port"]),
+					resource.TestCheckResourceAttr(testResourceName, "rise_count", accTestPolicyLBTCPMonitorProfileCreateAttributes["rise_count"]),
+					resource.TestCheckResourceAttr(testResourceName, "timeout", accTestPolicyLBTCPMonitorProfileCreateAttributes["timeout"]),
+					resource.TestCheckResourceAttr(testResourceName, "send", accTestPolicyLBTCPMonitorProfileCreateAttributes["send"]),
+					resource.TestCheckResourceAttr(testResourceName, "receive", accTestPolicyLBTCPMonitorProfileCreateAttributes["receive"]),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLBTCPMonitorProfileTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBTCPMonitorProfileExists(accTestPolicyLBTCPMonitorProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyLBTCPMonitorProfileUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyLBTCPMonitorProfileUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "fall_count", accTestPolicyLBTCPMonitorProfileUpdateAttributes["fall_count"]),
+					resource.TestCheckResourceAttr(testResourceName, "interval", accTestPolicyLBTCPMonitorProfileUpdateAttributes["interval"]),
+					resource.TestCheckResourceAttr(testResourceName, "monitor_port", accTestPolicyLBTCPMonitorProfileUpdateAttributes["monitor_port"]),
+					resource.TestCheckResourceAttr(testResourceName, "rise_count", accTestPolicyLBTCPMonitorProfileUpdateAttributes["rise_count"]),
+					resource.TestCheckResourceAttr(testResourceName, "timeout", accTestPolicyLBTCPMonitorProfileUpdateAttributes["timeout"]),
+					resource.TestCheckResourceAttr(testResourceName, "send", accTestPolicyLBTCPMonitorProfileUpdateAttributes["send"]),
+					resource.TestCheckResourceAttr(testResourceName, "receive", accTestPolicyLBTCPMonitorProfileUpdateAttributes["receive"]),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLBTCPMonitorProfileMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBTCPMonitorProfileExists(accTestPolicyLBTCPMonitorProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyLBTCPMonitorProfile_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_lb_tcp_monitor_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBTCPMonitorProfileCheckDestroy(state, accTestPolicyLBTCPMonitorProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBTCPMonitorProfileMinimalistic(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyLBTCPMonitorProfileExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy LB TCP Monitor Profile resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy LB TCP Monitor Profile resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyLBMonitorProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy LB TCP Monitor Profile %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyLBTCPMonitorProfileCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_lb_tcp_monitor_profile" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyLBMonitorProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err == nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy LB TCP Monitor Profile %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyLBTCPMonitorProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	var tags string
+	if createFlow {
+		attrMap = accTestPolicyLBTCPMonitorProfileCreateAttributes
+		tags = `
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }`
+	} else {
+		attrMap = accTestPolicyLBTCPMonitorProfileUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_lb_tcp_monitor_profile" "test" {
+  display_name = "%s"
+  description  = "%s"
+  fall_count   = %s
+  interval     = %s
+  monitor_port = "%s"
+  rise_count   = %s
+  timeout      = %s
+  send         = "%s"
+  receive      = "%s"
+%s
+}`, attrMap["display_name"], attrMap["description"], attrMap["fall_count"], attrMap["interval"], attrMap["monitor_port"], attrMap["rise_count"], attrMap["timeout"], attrMap["send"], attrMap["receive"], tags)
+}
+
+func testAccNsxtPolicyLBTCPMonitorProfileMinimalistic() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_lb_tcp_monitor_profile" "test" {
+  display_name = "%s"
+}`, accTestPolicyLBTCPMonitorProfileUpdateAttributes["display_name"])
+}
@@ -0,0 +1,206 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyLBUDPMonitorProfileCreateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform created",
+	"fall_count":   "2",
+	"interval":     "4",
+	"monitor_port": "7001",
+	"rise_count":   "5",
+	"timeout":      "10",
+	"send":         "ping",
+	"receive":      "pong",
+}
+
+var accTestPolicyLBUDPMonitorProfileUpdateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform updated",
+	"fall_count":   "3",
+	"interval":     "5",
+	"monitor_port": "7002",
+	"rise_count":   "3",
+	"timeout":      "15",
+	"send":         "hello",
+	"receive":      "world",
+}
+
+func TestAccResourceNsxtPolicyLBUDPMonitorProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_lb_udp_monitor_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBUDPMonitorProfileCheckDestroy(state, accTestPolicyLBUDPMonitorProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBUDPMonitorProfileTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBUDPMonitorProfileExists(accTestPolicyLBUDPMonitorProfileCreateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyLBUDPMonitorProfileCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyLBUDPMonitorProfileCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "fall_count", accTestPolicyLBUDPMonitorProfileCreateAttributes["fall_count"]),
+					resource.TestCheckResourceAttr(testResourceName, "interval", accTestPolicyLBUDPMonitorProfileCreateAttributes["interval"]),
+					resource.TestCheckResourceAttr(testResourceName, "monitor_port", accTestPolicyLBUDPMonitorProfileCreateAttributes["monitor_port"]),
+					resource.TestCheckResourceAttr(testResourceName, "rise_count", accTestPolicyLBUDPMonitorProfileCreateAttributes["rise_count"]),
+					resource.TestCheckResourceAttr(testResourceName, "timeout", accTestPolicyLBUDPMonitorProfileCreateAttributes["timeout"]),
+					resource.TestCheckResourceAttr(testResourceName, "send", accTestPolicyLBUDPMonitorProfileCreateAttributes["send"]),
+					resource.TestCheckResourceAttr(testResourceName, "receive", accTestPolicyLBUDPMonitorProfileCreateAttributes["receive"]),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLBUDPMonitorProfileTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBUDPMonitorProfileExists(accTestPolicyLBUDPMonitorProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyLBUDPMonitorProfileUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyLBUDPMonitorProfileUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "fall_count", accTestPolicyLBUDPMonitorProfileUpdateAttributes["fall_count"]),
+					resource.TestCheckResourceAttr(testResourceName, "interval", accTestPolicyLBUDPMonitorProfileUpdateAttributes["interval"]),
+					resource.TestCheckResourceAttr(testResourceName, "monitor_port", accTestPolicyLBUDPMonitorProfileUpdateAttributes["monitor_port"]),
+					resource.TestCheckResourceAttr(testResourceName, "rise_count", accTestPolicyLBUDPMonitorProfileUpdateAttributes["rise_count"]),
+					resource.TestCheckResourceAttr(testResourceName, "timeout", accTestPolicyLBUDPMonitorProfileUpdateAttributes["timeout"]),
+					resource.TestCheckResourceAttr(testResourceName, "send", accTestPolicyLBUDPMonitorProfileUpdateAttributes["send"]),
+					resource.TestCheckResourceAttr(testResourceName, "receive", accTestPolicyLBUDPMonitorProfileUpdateAttributes["receive"]),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLBUDPMonitorProfileMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBUDPMonitorProfileExists(accTestPolicyLBUDPMonitorProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyLBUDPMonitorProfile_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_lb_udp_monitor_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBUDPMonitorProfileCheckDestroy(state, accTestPolicyLBUDPMonitorProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBUDPMonitorProfileMinimalistic(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyLBUDPMonitorProfileExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy LB UDP Monitor Profile resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy LB UDP Monitor Profile resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyLBMonitorProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy LB UDP Monitor Profile %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyLBUDPMonitorProfileCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_lb_udp_monitor_profile" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyLBMonitorProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err == nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy LB UDP Monitor Profile %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyLBUDPMonitorProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	var tags string
+	if createFlow {
+		attrMap = accTestPolicyLBUDPMonitorProfileCreateAttributes
+		tags = `
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }`
+	} else {
+		attrMap = accTestPolicyLBUDPMonitorProfileUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_lb_udp_monitor_profile" "test" {
+  display_name = "%s"
+  description  = "%s"
+  fall_count   = %s
+  interval     = %s
+  monitor_port = "%s"
+  rise_count   = %s
+  timeout      = %s
+  send         = "%s"
+  receive      = "%s"
+%s
+}`, attrMap["display_name"], attrMap["description"], attrMap["fall_count"], attrMap["interval"], attrMap["monitor_port"], attrMap["rise_count"], attrMap["timeout"], attrMap["send"], attrMap["receive"], tags)
+}
+
+func testAccNsxtPolicyLBUDPMonitorProfileMinimalistic() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_lb_udp_monitor_profile" "test" {
+  display_name = "%s"
+  send         = "ping"
+  receive      = "pong"
+}`, accTestPolicyLBUDPMonitorProfileUpdateAttributes["display_name"])
+}
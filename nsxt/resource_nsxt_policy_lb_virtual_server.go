@@ -30,6 +30,12 @@ var lbAccessListControlActionValues = []string{
 	model.LBAccessListControl_ACTION_DROP,
 }
 
+var policyLBVirtualServerPathReferences = []policyPathReference{
+	{attribute: "service_path", resourceTypes: []string{"LBService"}},
+	{attribute: "pool_path", resourceTypes: []string{"LBPool"}},
+	{attribute: "sorry_pool_path", resourceTypes: []string{"LBPool"}},
+}
+
 func resourceNsxtPolicyLBVirtualServer() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNsxtPolicyLBVirtualServerCreate,
@@ -39,6 +45,7 @@ func resourceNsxtPolicyLBVirtualServer() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: policyPathsCustomizeDiff(policyLBVirtualServerPathReferences),
 
 		Schema: map[string]*schema.Schema{
 			"nsx_id":                   getNsxIDSchema(),
@@ -128,7 +135,7 @@ func resourceNsxtPolicyLBVirtualServer() *schema.Resource {
 				Optional:    true,
 				MaxItems:    1,
 			},
-			// TODO: add rules
+			"rule": getPolicyLbRuleSchema(),
 		},
 	}
 }
@@ -277,7 +284,7 @@ func setPolicyClientSSLBindingInSchema(d *schema.ResourceData, binding *model.LB
 
 	err := d.Set("client_ssl", bindingList)
 	if err != nil {
-		log.Printf("[WARNING] Failed to set client ssl in schema: %v", err)
+		addResourceWarning(d, "Failed to set client ssl in schema", err.Error())
 	}
 }
 
@@ -331,7 +338,7 @@ func setPolicyServerSSLBindingInSchema(d *schema.ResourceData, binding *model.LB
 
 	err := d.Set("server_ssl", bindingList)
 	if err != nil {
-		log.Printf("[WARNING] Failed to set server ssl in schema: %v", err)
+		addResourceWarning(d, "Failed to set server ssl in schema", err.Error())
 	}
 }
 
@@ -368,12 +375,12 @@ func setPolicyAccessListControlInSchema(d *schema.ResourceData, control *model.L
 
 	err := d.Set("access_list_control", controlList)
 	if err != nil {
-		log.Printf("[WARNING] Failed to set access list control in schema: %v", err)
+		addResourceWarning(d, "Failed to set access list control in schema", err.Error())
 	}
 }
 
-func policyLBVirtualServerVersionDepenantSet(d *schema.ResourceData, obj *model.LBVirtualServer) {
-	if nsxVersionHigherOrEqual("3.0.0") {
+func policyLBVirtualServerVersionDepenantSet(d *schema.ResourceData, m interface{}, obj *model.LBVirtualServer) {
+	if nsxVersionHigherOrEqual(m, "3.0.0") {
 		logSignificantOnly := d.Get("log_significant_event_only").(bool)
 		obj.LogSignificantEventOnly = &logSignificantOnly
 		obj.AccessListControl = getPolicyAccessListControlFromSchema(d)
@@ -396,13 +403,13 @@ func resourceNsxtPolicyLBVirtualServerExists(id string, connector *client.RestCo
 }
 
 func resourceNsxtPolicyLBVirtualServerCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
 	connector := getPolicyConnector(m)
 	client := infra.NewDefaultLbVirtualServersClient(connector)
 
-	if client == nil {
-		return policyResourceNotSupportedError()
-	}
-
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyLBVirtualServerExists)
 	if err != nil {
@@ -426,6 +433,10 @@ func resourceNsxtPolicyLBVirtualServerCreate(d *schema.ResourceData, m interface
 	ports := getStringListFromSchemaList(d, "ports")
 	serverSSLProfileBinding := getPolicyServerSSLBindingFromSchema(d)
 	sorryPoolPath := d.Get("sorry_pool_path").(string)
+	rules, err := getPolicyLbRulesFromSchema(d)
+	if err != nil {
+		return err
+	}
 
 	obj := model.LBVirtualServer{
 		DisplayName:              &displayName,
@@ -441,11 +452,12 @@ func resourceNsxtPolicyLBVirtualServerCreate(d *schema.ResourceData, m interface
 		LbServicePath:            &lbServicePath,
 		PoolPath:                 &poolPath,
 		Ports:                    ports,
+		Rules:                    rules,
 		ServerSslProfileBinding:  serverSSLProfileBinding,
 		SorryPoolPath:            &sorryPoolPath,
 	}
 
-	policyLBVirtualServerVersionDepenantSet(d, &obj)
+	policyLBVirtualServerVersionDepenantSet(d, m, &obj)
 
 	if maxNewConnectionRate > 0 {
 		obj.MaxNewConnectionRate = &maxNewConnectionRate
@@ -469,13 +481,13 @@ func resourceNsxtPolicyLBVirtualServerCreate(d *schema.ResourceData, m interface
 }
 
 func resourceNsxtPolicyLBVirtualServerRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
 	connector := getPolicyConnector(m)
 	client := infra.NewDefaultLbVirtualServersClient(connector)
 
-	if client == nil {
-		return policyResourceNotSupportedError()
-	}
-
 	id := d.Id()
 	if id == "" {
 		return fmt.Errorf("Error obtaining LBVirtualServer ID")
@@ -509,15 +521,17 @@ func resourceNsxtPolicyLBVirtualServerRead(d *schema.ResourceData, m interface{}
 	d.Set("sorry_pool_path", obj.SorryPoolPath)
 	d.Set("log_significant_event_only", obj.LogSignificantEventOnly)
 	setPolicyAccessListControlInSchema(d, obj.AccessListControl)
-	return nil
+
+	return setPolicyLbRulesInSchema(d, obj.Rules)
 }
 
 func resourceNsxtPolicyLBVirtualServerUpdate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
 	connector := getPolicyConnector(m)
 	client := infra.NewDefaultLbVirtualServersClient(connector)
-	if client == nil {
-		return policyResourceNotSupportedError()
-	}
 
 	id := d.Id()
 	if id == "" {
@@ -543,6 +557,10 @@ func resourceNsxtPolicyLBVirtualServerUpdate(d *schema.ResourceData, m interface
 	ports := getStringListFromSchemaList(d, "ports")
 	serverSSLProfileBinding := getPolicyServerSSLBindingFromSchema(d)
 	sorryPoolPath := d.Get("sorry_pool_path").(string)
+	rules, err := getPolicyLbRulesFromSchema(d)
+	if err != nil {
+		return err
+	}
 
 	obj := model.LBVirtualServer{
 		DisplayName:              &displayName,
@@ -558,21 +576,12 @@ func resourceNsxtPolicyLBVirtualServerUpdate(d *schema.ResourceData, m interface
 		LbServicePath:            &lbServicePath,
 		PoolPath:                 &poolPath,
 		Ports:                    ports,
+		Rules:                    rules,
 		ServerSslProfileBinding:  serverSSLProfileBinding,
 		SorryPoolPath:            &sorryPoolPath,
 	}
 
-	policyLBVirtualServerVersionDepenantSet(d, &obj)
-
-	// The user might have defined the rules outside terraform, lets keep these
-	existingObj, err := client.Get(id)
-	if err != nil {
-		return handleUpdateError("LBVirtualServer", id, err)
-	}
-
-	if len(existingObj.Rules) > 0 {
-		obj.Rules = existingObj.Rules
-	}
+	policyLBVirtualServerVersionDepenantSet(d, m, &obj)
 
 	if maxNewConnectionRate > 0 {
 		obj.MaxNewConnectionRate = &maxNewConnectionRate
@@ -597,11 +606,12 @@ func resourceNsxtPolicyLBVirtualServerDelete(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error obtaining LBVirtualServer ID")
 	}
 
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
 	connector := getPolicyConnector(m)
 	client := infra.NewDefaultLbVirtualServersClient(connector)
-	if client == nil {
-		return policyResourceNotSupportedError()
-	}
 
 	force := true
 	err := client.Delete(id, &force)
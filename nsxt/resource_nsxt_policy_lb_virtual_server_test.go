@@ -256,6 +256,58 @@ func TestAccResourceNsxtPolicyLBVirtualServer_withAccessList(t *testing.T) {
 	})
 }
 
+func TestAccResourceNsxtPolicyLBVirtualServer_withRules(t *testing.T) {
+	testResourceName := "nsxt_policy_lb_virtual_server.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t); testAccNSXVersion(t, "3.0.0") },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBVirtualServerCheckDestroy(state, accTestPolicyLBVirtualServerCreateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBVirtualServerWithRules(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBVirtualServerExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "3"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.display_name", "redirect-http"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.phase", "HTTP_REQUEST_REWRITE"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.match_strategy", "ANY"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.condition.0.http_request_header.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.condition.0.http_request_method.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.action.0.http_request_header_rewrite.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.1.phase", "HTTP_FORWARDING"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.1.condition.0.http_request_uri.0.uri", "/api"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.1.condition.0.ip_header.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "rule.1.action.0.select_pool.0.pool_id"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.2.phase", "HTTP_ACCESS"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.2.action.0.http_reject.0.reply_status", "403"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLBVirtualServerWithRules(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBVirtualServerExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.phase", "HTTP_FORWARDING"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.condition.0.http_request_uri.0.match_type", "REGEX"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.condition.0.http_request_cookie.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.action.0.http_redirect.0.redirect_status", "302"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.action.0.variable_assignment.#", "0"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLBVirtualServerMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBVirtualServerExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourceNsxtPolicyLBVirtualServer_importBasic(t *testing.T) {
 	name := getAccTestResourceName()
 	testResourceName := "nsxt_policy_lb_virtual_server.test"
@@ -491,3 +543,126 @@ data "nsxt_policy_realization_info" "realization_info" {
   path = nsxt_policy_lb_virtual_server.test.path
 }`, attrMap["display_name"], attrMap["ip_address"], attrMap["ports"], attrMap["log_significant_event_only"], attrMap["access_list_control_action"], attrMap["access_list_control_enabled"])
 }
+
+func testAccNsxtPolicyLBVirtualServerWithRules(createFlow bool) string {
+	attrMap := accTestPolicyLBVirtualServerCreateAttributes
+	rules := `
+  rule {
+    display_name   = "redirect-http"
+    phase          = "HTTP_REQUEST_REWRITE"
+    match_strategy = "ANY"
+
+    condition {
+      http_request_header {
+        header_name  = "X-Forwarded-Proto"
+        header_value = "http"
+        match_type   = "EQUALS"
+      }
+
+      http_request_method {
+        method = "GET"
+      }
+    }
+
+    action {
+      http_request_header_rewrite {
+        header_name  = "X-Forwarded-Proto"
+        header_value = "https"
+      }
+    }
+  }
+
+  rule {
+    display_name = "api-pool"
+
+    condition {
+      http_request_uri {
+        uri        = "/api"
+        match_type = "STARTS_WITH"
+      }
+
+      ip_header {
+        group_path = nsxt_policy_group.group1.path
+      }
+    }
+
+    action {
+      select_pool {
+        pool_id = nsxt_policy_lb_pool.pool.path
+      }
+    }
+  }
+
+  rule {
+    display_name = "deny-admin"
+    phase        = "HTTP_ACCESS"
+
+    condition {
+      http_request_uri {
+        uri        = "/admin"
+        match_type = "CONTAINS"
+        inverse    = false
+      }
+    }
+
+    action {
+      http_reject {
+        reply_status  = "403"
+        reply_message = "Forbidden"
+      }
+    }
+  }`
+	if !createFlow {
+		rules = `
+  rule {
+    display_name = "redirect-legacy"
+
+    condition {
+      http_request_uri {
+        uri            = "^/legacy/.*"
+        match_type     = "REGEX"
+        case_sensitive = false
+      }
+
+      http_request_cookie {
+        cookie_name  = "version"
+        cookie_value = "1"
+        match_type   = "EQUALS"
+      }
+    }
+
+    action {
+      http_redirect {
+        redirect_status = "302"
+        redirect_url    = "https://example.com/new"
+      }
+    }
+  }`
+	}
+
+	return fmt.Sprintf(`
+data "nsxt_policy_lb_app_profile" "default_http"{
+  type = "HTTP"
+}
+
+resource "nsxt_policy_lb_pool" "pool" {
+  display_name = "terraform-vs-test-pool"
+}
+
+resource "nsxt_policy_group" "group1" {
+  display_name = "terraform-vs-test-group1"
+}
+
+resource "nsxt_policy_lb_virtual_server" "test" {
+  display_name             = "%s"
+  application_profile_path = data.nsxt_policy_lb_app_profile.default_http.path
+  ip_address               = "%s"
+  ports                    = ["%s"]
+  pool_path                = nsxt_policy_lb_pool.pool.path
+%s
+}
+
+data "nsxt_policy_realization_info" "realization_info" {
+  path = nsxt_policy_lb_virtual_server.test.path
+}`, attrMap["display_name"], attrMap["ip_address"], attrMap["ports"], rules)
+}
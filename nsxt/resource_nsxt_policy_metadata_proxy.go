@@ -0,0 +1,224 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var policyMetadataProxyCryptoProtocolValues = []string{
+	model.MetadataProxyConfig_CRYPTO_PROTOCOLS_V1,
+	model.MetadataProxyConfig_CRYPTO_PROTOCOLS_V1_1,
+	model.MetadataProxyConfig_CRYPTO_PROTOCOLS_V1_2,
+}
+
+func resourceNsxtPolicyMetadataProxy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyMetadataProxyCreate,
+		Read:   resourceNsxtPolicyMetadataProxyRead,
+		Update: resourceNsxtPolicyMetadataProxyUpdate,
+		Delete: resourceNsxtPolicyMetadataProxyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: nsxVersionCustomizeDiff("3.0.0"),
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"server_address": {
+				Type:         schema.TypeString,
+				Description:  "URL of metadata server, port number should be between 3000-9000",
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"secret": {
+				Type:        schema.TypeString,
+				Description: "Secret word or phrase to access metadata server",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"edge_cluster_path": getPolicyPathSchema(true, false, "Policy path to Edge Cluster"),
+			"preferred_edge_paths": {
+				Type:        schema.TypeList,
+				Description: "Policy paths to preferred edge nodes, which should be members of edge cluster",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validatePolicyPath(),
+				},
+			},
+			"enable_standby_relocation": {
+				Type:        schema.TypeBool,
+				Description: "Flag to enable standby relocation, not applicable when preferred edge paths are set",
+				Optional:    true,
+				Default:     false,
+			},
+			"crypto_protocols": {
+				Type:        schema.TypeList,
+				Description: "Cryptographic protocols supported by metadata proxy",
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(policyMetadataProxyCryptoProtocolValues, false),
+				},
+			},
+			"server_certificates": {
+				Type:        schema.TypeList,
+				Description: "Policy paths to CA certificates used to verify metadata server certificate",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validatePolicyPath(),
+				},
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyMetadataProxyExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	client := infra.NewDefaultMetadataProxiesClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving Metadata Proxy", err)
+}
+
+func policyMetadataProxyPatch(id string, d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	serverAddress := d.Get("server_address").(string)
+	secret := d.Get("secret").(string)
+	edgeClusterPath := d.Get("edge_cluster_path").(string)
+	preferredEdgePaths := interfaceListToStringList(d.Get("preferred_edge_paths").([]interface{}))
+	enableStandbyRelocation := d.Get("enable_standby_relocation").(bool)
+	cryptoProtocols := interfaceListToStringList(d.Get("crypto_protocols").([]interface{}))
+	serverCertificates := interfaceListToStringList(d.Get("server_certificates").([]interface{}))
+
+	obj := model.MetadataProxyConfig{
+		DisplayName:             &displayName,
+		Description:             &description,
+		Tags:                    tags,
+		ServerAddress:           &serverAddress,
+		Secret:                  &secret,
+		EdgeClusterPath:         &edgeClusterPath,
+		PreferredEdgePaths:      preferredEdgePaths,
+		EnableStandbyRelocation: &enableStandbyRelocation,
+		ServerCertificates:      serverCertificates,
+	}
+
+	if len(cryptoProtocols) > 0 {
+		obj.CryptoProtocols = cryptoProtocols
+	}
+
+	client := infra.NewDefaultMetadataProxiesClient(connector)
+	return client.Patch(id, obj)
+}
+
+func resourceNsxtPolicyMetadataProxyCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyMetadataProxyExists)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating Metadata Proxy with ID %s", id)
+	err = policyMetadataProxyPatch(id, d, m)
+	if err != nil {
+		return handleCreateError("Metadata Proxy", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyMetadataProxyRead(d, m)
+}
+
+func resourceNsxtPolicyMetadataProxyRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Metadata Proxy ID")
+	}
+
+	client := infra.NewDefaultMetadataProxiesClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "Metadata Proxy", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	// Secret is not returned by NSX, hence it is not set here
+	d.Set("server_address", obj.ServerAddress)
+	d.Set("edge_cluster_path", obj.EdgeClusterPath)
+	d.Set("preferred_edge_paths", obj.PreferredEdgePaths)
+	d.Set("enable_standby_relocation", obj.EnableStandbyRelocation)
+	d.Set("crypto_protocols", obj.CryptoProtocols)
+	d.Set("server_certificates", obj.ServerCertificates)
+
+	return nil
+}
+
+func resourceNsxtPolicyMetadataProxyUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Metadata Proxy ID")
+	}
+
+	log.Printf("[INFO] Updating Metadata Proxy with ID %s", id)
+	err := policyMetadataProxyPatch(id, d, m)
+	if err != nil {
+		return handleUpdateError("Metadata Proxy", id, err)
+	}
+
+	return resourceNsxtPolicyMetadataProxyRead(d, m)
+}
+
+func resourceNsxtPolicyMetadataProxyDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Metadata Proxy ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewDefaultMetadataProxiesClient(connector)
+	err := client.Delete(id)
+	if err != nil {
+		return handleDeleteError("Metadata Proxy", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,208 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyMetadataProxyCreateAttributes = map[string]string{
+	"display_name":   getAccTestResourceName(),
+	"description":    "terraform created",
+	"server_address": "http://192.168.1.10:3000",
+	"secret":         "test-secret1",
+}
+
+var accTestPolicyMetadataProxyUpdateAttributes = map[string]string{
+	"display_name":   getAccTestResourceName(),
+	"description":    "terraform updated",
+	"server_address": "http://192.168.1.20:3500/meta",
+	"secret":         "test-secret2",
+}
+
+func TestAccResourceNsxtPolicyMetadataProxy_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_metadata_proxy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t); testAccNSXVersion(t, "3.0.0") },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyMetadataProxyCheckDestroy(state, accTestPolicyMetadataProxyUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyMetadataProxyTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyMetadataProxyExists(accTestPolicyMetadataProxyCreateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyMetadataProxyCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyMetadataProxyCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "server_address", accTestPolicyMetadataProxyCreateAttributes["server_address"]),
+					resource.TestCheckResourceAttr(testResourceName, "secret", accTestPolicyMetadataProxyCreateAttributes["secret"]),
+					resource.TestCheckResourceAttr(testResourceName, "crypto_protocols.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "edge_cluster_path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttr("nsxt_policy_segment.test", "metadata_proxy_paths.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyMetadataProxyTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyMetadataProxyExists(accTestPolicyMetadataProxyUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyMetadataProxyUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyMetadataProxyUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "server_address", accTestPolicyMetadataProxyUpdateAttributes["server_address"]),
+					resource.TestCheckResourceAttr(testResourceName, "secret", accTestPolicyMetadataProxyUpdateAttributes["secret"]),
+					resource.TestCheckResourceAttr(testResourceName, "crypto_protocols.#", "2"),
+					resource.TestCheckResourceAttrSet(testResourceName, "edge_cluster_path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+					resource.TestCheckResourceAttr("nsxt_policy_segment.test", "metadata_proxy_paths.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyMetadataProxyDetachTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nsxt_policy_segment.test", "metadata_proxy_paths.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyMetadataProxy_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_metadata_proxy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t); testAccNSXVersion(t, "3.0.0") },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyMetadataProxyCheckDestroy(state, accTestPolicyMetadataProxyCreateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyMetadataProxyTemplate(true),
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret"},
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyMetadataProxyExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy Metadata Proxy resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy Metadata Proxy resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyMetadataProxyExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy Metadata Proxy %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyMetadataProxyCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_metadata_proxy" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyMetadataProxyExists(resourceID, connector, testAccIsGlobalManager())
+		if err == nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy Metadata Proxy %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyMetadataProxyPrerequisites() string {
+	return testAccNsxtPolicyEdgeClusterReadTemplate(getEdgeClusterName()) +
+		testAccNSXPolicyTransportZoneReadTemplate(getOverlayTransportZoneName(), false, true)
+}
+
+func testAccNsxtPolicyMetadataProxyTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	var extraConfig string
+	if createFlow {
+		attrMap = accTestPolicyMetadataProxyCreateAttributes
+		extraConfig = `
+  crypto_protocols = ["TLS_V1_2"]
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }`
+	} else {
+		attrMap = accTestPolicyMetadataProxyUpdateAttributes
+		extraConfig = `
+  crypto_protocols = ["TLS_V1_1", "TLS_V1_2"]`
+	}
+	return testAccNsxtPolicyMetadataProxyPrerequisites() + fmt.Sprintf(`
+resource "nsxt_policy_metadata_proxy" "test" {
+  display_name      = "%s"
+  description       = "%s"
+  server_address    = "%s"
+  secret            = "%s"
+  edge_cluster_path = data.nsxt_policy_edge_cluster.test.path
+%s
+}
+
+resource "nsxt_policy_segment" "test" {
+  display_name         = "%s"
+  transport_zone_path  = data.nsxt_policy_transport_zone.test.path
+  metadata_proxy_paths = [nsxt_policy_metadata_proxy.test.path]
+}`, attrMap["display_name"], attrMap["description"], attrMap["server_address"], attrMap["secret"], extraConfig, attrMap["display_name"])
+}
+
+func testAccNsxtPolicyMetadataProxyDetachTemplate() string {
+	attrMap := accTestPolicyMetadataProxyUpdateAttributes
+	return testAccNsxtPolicyMetadataProxyPrerequisites() + fmt.Sprintf(`
+resource "nsxt_policy_metadata_proxy" "test" {
+  display_name      = "%s"
+  description       = "%s"
+  server_address    = "%s"
+  secret            = "%s"
+  edge_cluster_path = data.nsxt_policy_edge_cluster.test.path
+  crypto_protocols  = ["TLS_V1_1", "TLS_V1_2"]
+}
+
+resource "nsxt_policy_segment" "test" {
+  display_name        = "%s"
+  transport_zone_path = data.nsxt_policy_transport_zone.test.path
+}`, attrMap["display_name"], attrMap["description"], attrMap["server_address"], attrMap["secret"], attrMap["display_name"])
+}
@@ -34,6 +34,7 @@ func resourceNsxtPolicyOspfArea() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyOspfAreaImport,
 		},
+		CustomizeDiff: nsxVersionCustomizeDiff("3.1.0"),
 
 		Schema: map[string]*schema.Schema{
 			"nsx_id":       getNsxIDSchema(),
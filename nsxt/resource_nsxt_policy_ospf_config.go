@@ -20,7 +20,8 @@ func resourceNsxtPolicyOspfConfig() *schema.Resource {
 		Update: resourceNsxtPolicyOspfConfigUpdate,
 		Delete: resourceNsxtPolicyOspfConfigDelete,
 
-		Schema: getPolicyOspfConfigSchema(),
+		CustomizeDiff: nsxVersionCustomizeDiff("3.1.0"),
+		Schema:        getPolicyOspfConfigSchema(),
 	}
 }
 
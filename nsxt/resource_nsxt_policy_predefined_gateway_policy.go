@@ -266,7 +266,7 @@ func createChildDomainWithGatewayPolicy(domain string, policyID string, policy m
 func gatewayPolicyInfraPatch(policy model.GatewayPolicy, domain string, m interface{}) error {
 	childDomain, err := createChildDomainWithGatewayPolicy(domain, *policy.Id, policy)
 	if err != nil {
-		return fmt.Errorf("Failed to create H-API for Predefined Gateway Policy: %s", err)
+		return fmt.Errorf("Failed to create H-API for Predefined Gateway Policy: %w", err)
 	}
 
 	var infraChildren []*data.StructValue
@@ -483,7 +483,7 @@ func resourceNsxtPolicyPredefinedGatewayPolicyDelete(d *schema.ResourceData, m i
 
 	revertedPolicy, err := revertPolicyPredefinedGatewayPolicy(predefinedPolicy, m)
 	if err != nil {
-		return fmt.Errorf("Failed to revert Predefined Gateway Policy %s: %s", id, err)
+		return fmt.Errorf("Failed to revert Predefined Gateway Policy %s: %w", id, err)
 	}
 
 	err = gatewayPolicyInfraPatch(revertedPolicy, domain, m)
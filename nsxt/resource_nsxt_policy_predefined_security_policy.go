@@ -408,7 +408,7 @@ func resourceNsxtPolicyPredefinedSecurityPolicyDelete(d *schema.ResourceData, m
 
 	revertedPolicy, err := revertPolicyPredefinedSecurityPolicy(predefinedPolicy, m)
 	if err != nil {
-		return fmt.Errorf("Failed to revert Predefined Security Policy %s: %s", id, err)
+		return fmt.Errorf("Failed to revert Predefined Security Policy %s: %w", id, err)
 	}
 
 	err = securityPolicyInfraPatch(revertedPolicy, domain, m)
@@ -421,7 +421,7 @@ func resourceNsxtPolicyPredefinedSecurityPolicyDelete(d *schema.ResourceData, m
 func securityPolicyInfraPatch(policy model.SecurityPolicy, domain string, m interface{}) error {
 	childDomain, err := createChildDomainWithSecurityPolicy(domain, *policy.Id, policy)
 	if err != nil {
-		return fmt.Errorf("Failed to create H-API for Predefined Security Policy: %s", err)
+		return fmt.Errorf("Failed to create H-API for Predefined Security Policy: %w", err)
 	}
 
 	var infraChildren []*data.StructValue
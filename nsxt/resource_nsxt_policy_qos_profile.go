@@ -132,7 +132,7 @@ func setPolicyQosRateShaperInSchema(d *schema.ResourceData, shaperConf []*data.S
 			shapers = append(shapers, elem)
 			err := d.Set(schemaName, shapers)
 			if err != nil {
-				log.Printf("[WARNING] Failed to set shapers in schema: %v", err)
+				addResourceWarning(d, "Failed to set shapers in schema", err.Error())
 			}
 		}
 	}
@@ -255,10 +255,6 @@ func resourceNsxtPolicyQosProfileRead(d *schema.ResourceData, m interface{}) err
 
 func resourceNsxtPolicyQosProfileUpdate(d *schema.ResourceData, m interface{}) error {
 	connector := getPolicyConnector(m)
-	client := infra.NewDefaultQosProfilesClient(connector)
-	if client == nil {
-		return policyResourceNotSupportedError()
-	}
 
 	id := d.Id()
 	if id == "" {
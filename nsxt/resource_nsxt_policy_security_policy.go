@@ -24,7 +24,8 @@ func resourceNsxtPolicySecurityPolicy() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: nsxtDomainResourceImporter,
 		},
-		Schema: getPolicySecurityPolicySchema(false),
+		Schema:        getPolicySecurityPolicySchema(false),
+		CustomizeDiff: policyPathsCustomizeDiff(policyRulePathReferences),
 	}
 }
 
@@ -107,6 +108,12 @@ func resourceNsxtPolicySecurityPolicyCreate(d *schema.ResourceData, m interface{
 		TcpStrict:      &tcpStrict,
 		Rules:          rules,
 	}
+
+	err = ensurePolicyFirewallAutoDraft(m)
+	if err != nil {
+		return err
+	}
+
 	log.Printf("[INFO] Creating Security Policy with ID %s", id)
 	if isPolicyGlobalManager(m) {
 		gmObj, err1 := convertModelBindingType(obj, model.SecurityPolicyBindingType(), gm_model.SecurityPolicyBindingType())
@@ -214,7 +221,11 @@ func resourceNsxtPolicySecurityPolicyUpdate(d *schema.ResourceData, m interface{
 		Rules:          rules,
 	}
 
-	var err error
+	err := ensurePolicyFirewallAutoDraft(m)
+	if err != nil {
+		return err
+	}
+
 	if isPolicyGlobalManager(m) {
 		gmObj, err1 := convertModelBindingType(obj, model.SecurityPolicyBindingType(), gm_model.SecurityPolicyBindingType())
 		if err1 != nil {
@@ -244,9 +255,12 @@ func resourceNsxtPolicySecurityPolicyDelete(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error obtaining Security Policy id")
 	}
 
-	connector := getPolicyConnector(m)
-	var err error
+	err := ensurePolicyFirewallAutoDraft(m)
+	if err != nil {
+		return err
+	}
 
+	connector := getPolicyConnector(m)
 	if isPolicyGlobalManager(m) {
 		client := gm_domains.NewDefaultSecurityPoliciesClient(connector)
 		err = client.Delete(d.Get("domain").(string), id)
@@ -18,6 +18,9 @@ func resourceNsxtPolicySegment() *schema.Resource {
 		},
 
 		Schema: getPolicyCommonSegmentSchema(false, false),
+		CustomizeDiff: policyPathsCustomizeDiff([]policyPathReference{
+			{attribute: "connectivity_path", resourceTypes: []string{"Tier0", "Tier1"}},
+		}),
 	}
 }
 
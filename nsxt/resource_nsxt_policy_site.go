@@ -0,0 +1,279 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/sites/enforcement_points"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+)
+
+func resourceNsxtPolicySite() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicySiteCreate,
+		Read:   resourceNsxtPolicySiteRead,
+		Update: resourceNsxtPolicySiteUpdate,
+		Delete: resourceNsxtPolicySiteDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: nsxVersionCustomizeDiff("3.1.0"),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(policyFullSyncDefaultTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"fail_if_rtep_misconfigured": {
+				Type:        schema.TypeBool,
+				Description: "Fail onboarding if RTEPs misconfigured",
+				Optional:    true,
+				Default:     true,
+			},
+			"fail_if_rtt_exceeded": getPolicyFailIfRttExceededSchema(),
+			"maximum_rtt":          getPolicyMaximumRttSchema(),
+			"site_connection_info": getPolicySiteConnectionInfoSchema("Connection information of the Local Manager"),
+			"transit_subnet": {
+				Type:         schema.TypeString,
+				Description:  "Subnet for inter-site transit segment connecting stretched gateways",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateCidr(),
+			},
+			"rtep_ips": {
+				Type:        schema.TypeList,
+				Description: "Remote tunnel endpoint IP addresses of the site edge nodes",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"edge_cluster": {
+				Type:        schema.TypeList,
+				Description: "Remote tunnel endpoint configuration of the site edge clusters",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of the edge cluster",
+							Computed:    true,
+						},
+						"inter_site_forwarding_enabled": {
+							Type:        schema.TypeBool,
+							Description: "Whether inter-site forwarding is enabled on the edge cluster",
+							Computed:    true,
+						},
+						"rtep_ips": {
+							Type:        schema.TypeList,
+							Description: "Remote tunnel endpoint IP addresses of the edge cluster nodes",
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicySiteExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	client := gm_infra.NewDefaultSitesClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving Site", err)
+}
+
+func policySitePatch(id string, d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	client := gm_infra.NewDefaultSitesClient(connector)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyGlobalManagerTagsFromSchema(d)
+	failIfRtepMisconfigured := d.Get("fail_if_rtep_misconfigured").(bool)
+	failIfRttExceeded := d.Get("fail_if_rtt_exceeded").(bool)
+	maximumRtt := int64(d.Get("maximum_rtt").(int))
+
+	obj := gm_model.Site{
+		DisplayName:             &displayName,
+		Description:             &description,
+		Tags:                    tags,
+		FailIfRtepMisconfigured: &failIfRtepMisconfigured,
+		FailIfRttExceeded:       &failIfRttExceeded,
+		MaximumRtt:              &maximumRtt,
+		SiteConnectionInfo:      getPolicySiteConnectionInfoFromSchema(d, "site_connection_info"),
+	}
+
+	transitSubnet := d.Get("transit_subnet").(string)
+	if transitSubnet != "" {
+		obj.FederationConfig = &gm_model.GmFederationSiteConfig{
+			TransitSubnet: &transitSubnet,
+		}
+	}
+
+	return client.Patch(id, obj)
+}
+
+func resourceNsxtPolicySiteCreate(d *schema.ResourceData, m interface{}) error {
+	if !isPolicyGlobalManager(m) {
+		return globalManagerOnlyError()
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicySiteExists)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating Site with ID %s", id)
+	err = policySitePatch(id, d, m)
+	if err != nil {
+		return handleCreateError("Site", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	err = policyWaitForFullSync(m, id, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	return resourceNsxtPolicySiteRead(d, m)
+}
+
+func resourceNsxtPolicySiteRead(d *schema.ResourceData, m interface{}) error {
+	if !isPolicyGlobalManager(m) {
+		return globalManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	client := gm_infra.NewDefaultSitesClient(connector)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Site ID")
+	}
+
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "Site", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyGlobalManagerTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("fail_if_rtep_misconfigured", obj.FailIfRtepMisconfigured)
+	d.Set("fail_if_rtt_exceeded", obj.FailIfRttExceeded)
+	d.Set("maximum_rtt", obj.MaximumRtt)
+	setPolicySiteConnectionInfoInSchema(d, "site_connection_info", obj.SiteConnectionInfo)
+	if obj.FederationConfig != nil {
+		d.Set("transit_subnet", obj.FederationConfig.TransitSubnet)
+	}
+
+	federationConfig, err := gm_infra.NewDefaultFederationConfigClient(connector).Get()
+	if err != nil {
+		return handleReadError(d, "FederationConfig", id, err)
+	}
+	var rtepIPs []string
+	for _, siteConfig := range federationConfig.SiteConfig {
+		if siteConfig.SitePath != nil && obj.Path != nil && *siteConfig.SitePath == *obj.Path {
+			rtepIPs = siteConfig.RtepIps
+			break
+		}
+	}
+	d.Set("rtep_ips", rtepIPs)
+
+	return setPolicySiteEdgeClustersInSchema(d, m, id)
+}
+
+func setPolicySiteEdgeClustersInSchema(d *schema.ResourceData, m interface{}, siteID string) error {
+	client := enforcement_points.NewDefaultEdgeClustersClient(getPolicyConnector(m))
+	var edgeClusters []map[string]interface{}
+	var cursor *string
+	for {
+		edgeClusterList, err := client.List(siteID, getPolicyEnforcementPoint(m), cursor, nil, nil, nil, nil, nil)
+		if err != nil {
+			return logAPIError("Error listing Site edge clusters", err)
+		}
+
+		for _, edgeCluster := range edgeClusterList.Results {
+			elem := make(map[string]interface{})
+			elem["path"] = edgeCluster.Path
+			elem["inter_site_forwarding_enabled"] = edgeCluster.InterSiteForwardingEnabled
+			elem["rtep_ips"] = edgeCluster.RtepIps
+			edgeClusters = append(edgeClusters, elem)
+		}
+
+		cursor = edgeClusterList.Cursor
+		if cursor == nil || *cursor == "" {
+			break
+		}
+	}
+
+	return d.Set("edge_cluster", edgeClusters)
+}
+
+func resourceNsxtPolicySiteUpdate(d *schema.ResourceData, m interface{}) error {
+	if !isPolicyGlobalManager(m) {
+		return globalManagerOnlyError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Site ID")
+	}
+
+	log.Printf("[INFO] Updating Site with ID %s", id)
+	err := policySitePatch(id, d, m)
+	if err != nil {
+		return handleUpdateError("Site", id, err)
+	}
+
+	return resourceNsxtPolicySiteRead(d, m)
+}
+
+func resourceNsxtPolicySiteDelete(d *schema.ResourceData, m interface{}) error {
+	if !isPolicyGlobalManager(m) {
+		return globalManagerOnlyError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Site ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := gm_infra.NewDefaultSitesClient(connector)
+	err := client.Delete(id, nil)
+	if err != nil {
+		return handleDeleteError("Site", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicySiteCreateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform created",
+	"maximum_rtt":  "250",
+}
+
+var accTestPolicySiteUpdateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform updated",
+	"maximum_rtt":  "500",
+}
+
+func testAccPolicySitePreCheck(t *testing.T) {
+	testAccOnlyGlobalManager(t)
+	testAccEnvDefined(t, "NSXT_TEST_LM_HOST")
+	testAccEnvDefined(t, "NSXT_TEST_LM_USERNAME")
+	testAccEnvDefined(t, "NSXT_TEST_LM_PASSWORD")
+	testAccPreCheck(t)
+}
+
+func TestAccResourceNsxtPolicySite_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_site.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPolicySitePreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicySiteCheckDestroy(state, accTestPolicySiteUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySiteTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicySiteExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicySiteCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicySiteCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "maximum_rtt", accTestPolicySiteCreateAttributes["maximum_rtt"]),
+					resource.TestCheckResourceAttr(testResourceName, "site_connection_info.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "site_connection_info.0.thumbprint"),
+					resource.TestCheckResourceAttrSet(testResourceName, "edge_cluster.#"),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicySiteTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicySiteExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicySiteUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicySiteUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "maximum_rtt", accTestPolicySiteUpdateAttributes["maximum_rtt"]),
+					resource.TestCheckResourceAttr(testResourceName, "site_connection_info.#", "1"),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicySite_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_site.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPolicySitePreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicySiteCheckDestroy(state, accTestPolicySiteUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySiteTemplate(false),
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"site_connection_info.0.password"},
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySiteExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy Site resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy Site resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicySiteExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy Site %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicySiteCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_site" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicySiteExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy Site %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicySiteTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicySiteCreateAttributes
+	} else {
+		attrMap = accTestPolicySiteUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_site" "test" {
+  display_name = "%s"
+  description  = "%s"
+  maximum_rtt  = %s
+
+  site_connection_info {
+    fqdn     = "%s"
+    username = "%s"
+    password = "%s"
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["maximum_rtt"], os.Getenv("NSXT_TEST_LM_HOST"), os.Getenv("NSXT_TEST_LM_USERNAME"), os.Getenv("NSXT_TEST_LM_PASSWORD"))
+}
@@ -0,0 +1,224 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_locale_services "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/tier_0s/locale_services"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+)
+
+func resourceNsxtPolicyTier0DeploymentMap() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyTier0DeploymentMapCreate,
+		Read:   resourceNsxtPolicyTier0DeploymentMapRead,
+		Update: resourceNsxtPolicyTier0DeploymentMapUpdate,
+		Delete: resourceNsxtPolicyTier0DeploymentMapDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtPolicyTier0DeploymentMapImport,
+		},
+		CustomizeDiff: nsxVersionCustomizeDiff("3.1.0"),
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":                 getNsxIDSchema(),
+			"path":                   getPathSchema(),
+			"display_name":           getDisplayNameSchema(),
+			"description":            getDescriptionSchema(),
+			"revision":               getRevisionSchema(),
+			"tag":                    getTagsSchema(),
+			"gateway_path":           getPolicyPathSchema(true, true, "Policy path for Tier0 gateway"),
+			"enforcement_point_path": getPolicyPathSchema(true, false, "Path of the site enforcement point to deploy the Tier0 gateway on"),
+			"locale_service_id": {
+				Type:        schema.TypeString,
+				Description: "Id of Tier0 gateway locale service. By default, locale service with edge cluster on the site of the enforcement point is used",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyTier0DeploymentMapExists(gwID string, localeServiceID string, id string, connector *client.RestConnector) (bool, error) {
+	client := gm_locale_services.NewDefaultTier0DeploymentMapsClient(connector)
+	_, err := client.Get(gwID, localeServiceID, id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving Tier0DeploymentMap", err)
+}
+
+func resourceNsxtPolicyTier0DeploymentMapExistsPartial(gwID string, localeServiceID string) func(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	return func(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+		return resourceNsxtPolicyTier0DeploymentMapExists(gwID, localeServiceID, id, connector)
+	}
+}
+
+// Returns gateway ID and locale service ID of the deployment map
+func getPolicyTier0DeploymentMapParentIDs(d *schema.ResourceData, m interface{}) (string, string, error) {
+	gwID := getPolicyIDFromPath(d.Get("gateway_path").(string))
+	localeServiceID := d.Get("locale_service_id").(string)
+	if localeServiceID != "" {
+		return gwID, localeServiceID, nil
+	}
+
+	enforcementPointPath := d.Get("enforcement_point_path").(string)
+	if len(strings.Split(enforcementPointPath, "/")) < 4 {
+		return "", "", fmt.Errorf("Invalid enforcement point path %s", enforcementPointPath)
+	}
+	localeServiceID, err := findTier0LocaleServiceForSite(getPolicyConnector(m), gwID, getSitePathFromEdgePath(enforcementPointPath))
+	if err != nil {
+		return "", "", err
+	}
+
+	return gwID, localeServiceID, nil
+}
+
+func policyTier0DeploymentMapPatch(gwID string, localeServiceID string, id string, d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	client := gm_locale_services.NewDefaultTier0DeploymentMapsClient(connector)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyGlobalManagerTagsFromSchema(d)
+	enforcementPointPath := d.Get("enforcement_point_path").(string)
+
+	obj := gm_model.Tier0DeploymentMap{
+		DisplayName:      &displayName,
+		Description:      &description,
+		Tags:             tags,
+		EnforcementPoint: &enforcementPointPath,
+	}
+
+	_, err := client.Patch(gwID, localeServiceID, id, obj)
+	return err
+}
+
+func resourceNsxtPolicyTier0DeploymentMapCreate(d *schema.ResourceData, m interface{}) error {
+	if !isPolicyGlobalManager(m) {
+		return globalManagerOnlyError()
+	}
+
+	gwID, localeServiceID, err := getPolicyTier0DeploymentMapParentIDs(d, m)
+	if err != nil {
+		return err
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyTier0DeploymentMapExistsPartial(gwID, localeServiceID))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating Tier0DeploymentMap with ID %s", id)
+	err = policyTier0DeploymentMapPatch(gwID, localeServiceID, id, d, m)
+	if err != nil {
+		return handleCreateError("Tier0DeploymentMap", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+	d.Set("locale_service_id", localeServiceID)
+
+	return resourceNsxtPolicyTier0DeploymentMapRead(d, m)
+}
+
+func resourceNsxtPolicyTier0DeploymentMapRead(d *schema.ResourceData, m interface{}) error {
+	if !isPolicyGlobalManager(m) {
+		return globalManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	client := gm_locale_services.NewDefaultTier0DeploymentMapsClient(connector)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Tier0DeploymentMap ID")
+	}
+
+	gwID := getPolicyIDFromPath(d.Get("gateway_path").(string))
+	localeServiceID := d.Get("locale_service_id").(string)
+	obj, err := client.Get(gwID, localeServiceID, id)
+	if err != nil {
+		return handleReadError(d, "Tier0DeploymentMap", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyGlobalManagerTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("enforcement_point_path", obj.EnforcementPoint)
+
+	return nil
+}
+
+func resourceNsxtPolicyTier0DeploymentMapUpdate(d *schema.ResourceData, m interface{}) error {
+	if !isPolicyGlobalManager(m) {
+		return globalManagerOnlyError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Tier0DeploymentMap ID")
+	}
+
+	gwID := getPolicyIDFromPath(d.Get("gateway_path").(string))
+	localeServiceID := d.Get("locale_service_id").(string)
+	log.Printf("[INFO] Updating Tier0DeploymentMap with ID %s", id)
+	err := policyTier0DeploymentMapPatch(gwID, localeServiceID, id, d, m)
+	if err != nil {
+		return handleUpdateError("Tier0DeploymentMap", id, err)
+	}
+
+	return resourceNsxtPolicyTier0DeploymentMapRead(d, m)
+}
+
+func resourceNsxtPolicyTier0DeploymentMapDelete(d *schema.ResourceData, m interface{}) error {
+	if !isPolicyGlobalManager(m) {
+		return globalManagerOnlyError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Tier0DeploymentMap ID")
+	}
+
+	gwID := getPolicyIDFromPath(d.Get("gateway_path").(string))
+	localeServiceID := d.Get("locale_service_id").(string)
+	connector := getPolicyConnector(m)
+	client := gm_locale_services.NewDefaultTier0DeploymentMapsClient(connector)
+	err := client.Delete(gwID, localeServiceID, id)
+	if err != nil {
+		return handleDeleteError("Tier0DeploymentMap", id, err)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyTier0DeploymentMapImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	s := strings.Split(importID, "/")
+	if len(s) != 3 {
+		return nil, fmt.Errorf("Please provide <gateway-id>/<locale-service-id>/<deployment-map-id> as an input")
+	}
+
+	d.Set("gateway_path", fmt.Sprintf("/global-infra/tier-0s/%s", s[0]))
+	d.Set("locale_service_id", s[1])
+	d.SetId(s[2])
+
+	return []*schema.ResourceData{d}, nil
+}
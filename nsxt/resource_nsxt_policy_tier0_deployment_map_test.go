@@ -0,0 +1,201 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyTier0DeploymentMapCreateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform created",
+}
+
+var accTestPolicyTier0DeploymentMapUpdateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform updated",
+}
+
+func testAccPolicyTier0DeploymentMapPreCheck(t *testing.T) {
+	testAccOnlyGlobalManager(t)
+	testAccEnvDefined(t, "NSXT_TEST_SITE_NAME")
+	testAccPreCheck(t)
+}
+
+func TestAccResourceNsxtPolicyTier0DeploymentMap_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_tier0_deployment_map.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPolicyTier0DeploymentMapPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyTier0DeploymentMapCheckDestroy(state, accTestPolicyTier0DeploymentMapUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTier0DeploymentMapTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTier0DeploymentMapExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyTier0DeploymentMapCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyTier0DeploymentMapCreateAttributes["description"]),
+					resource.TestCheckResourceAttrSet(testResourceName, "enforcement_point_path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "gateway_path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "locale_service_id"),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyTier0DeploymentMapTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTier0DeploymentMapExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyTier0DeploymentMapUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyTier0DeploymentMapUpdateAttributes["description"]),
+					resource.TestCheckResourceAttrSet(testResourceName, "enforcement_point_path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "gateway_path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "locale_service_id"),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyTier0DeploymentMap_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_tier0_deployment_map.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPolicyTier0DeploymentMapPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyTier0DeploymentMapCheckDestroy(state, accTestPolicyTier0DeploymentMapUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTier0DeploymentMapTemplate(false),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccNsxtPolicyTier0DeploymentMapImporterGetID,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyTier0DeploymentMapImporterGetID(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources["nsxt_policy_tier0_deployment_map.test"]
+	if !ok {
+		return "", fmt.Errorf("Policy Tier0DeploymentMap resource not found in resources")
+	}
+	resourceID := rs.Primary.ID
+	if resourceID == "" {
+		return "", fmt.Errorf("Policy Tier0DeploymentMap resource ID not set in resources")
+	}
+	gwPath := rs.Primary.Attributes["gateway_path"]
+	if gwPath == "" {
+		return "", fmt.Errorf("Policy Tier0DeploymentMap gateway_path not set in resources")
+	}
+	localeServiceID := rs.Primary.Attributes["locale_service_id"]
+	if localeServiceID == "" {
+		return "", fmt.Errorf("Policy Tier0DeploymentMap locale_service_id not set in resources")
+	}
+	return fmt.Sprintf("%s/%s/%s", getPolicyIDFromPath(gwPath), localeServiceID, resourceID), nil
+}
+
+func testAccNsxtPolicyTier0DeploymentMapExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy Tier0DeploymentMap resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy Tier0DeploymentMap resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyTier0DeploymentMapExists(getPolicyIDFromPath(rs.Primary.Attributes["gateway_path"]), rs.Primary.Attributes["locale_service_id"], resourceID, connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy Tier0DeploymentMap %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyTier0DeploymentMapCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_tier0_deployment_map" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyTier0DeploymentMapExists(getPolicyIDFromPath(rs.Primary.Attributes["gateway_path"]), rs.Primary.Attributes["locale_service_id"], resourceID, connector)
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy Tier0DeploymentMap %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+// Tier0 gateway is located on the site by its locale service, and deployed
+// on that site by the deployment map
+func testAccNsxtPolicyTier0DeploymentMapDeps() string {
+	return testAccNsxtGlobalPolicyEdgeClusterReadTemplate() + fmt.Sprintf(`
+
+resource "nsxt_policy_tier0_gateway" "test" {
+  display_name = "%s"
+
+  locale_service {
+    edge_cluster_path = data.nsxt_policy_edge_cluster.test.path
+  }
+}`, getAccTestResourceName())
+}
+
+func testAccNsxtPolicyTier0DeploymentMapTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyTier0DeploymentMapCreateAttributes
+	} else {
+		attrMap = accTestPolicyTier0DeploymentMapUpdateAttributes
+	}
+	return testAccNsxtPolicyTier0DeploymentMapDeps() + fmt.Sprintf(`
+
+resource "nsxt_policy_tier0_deployment_map" "test" {
+  display_name           = "%s"
+  description            = "%s"
+  gateway_path           = nsxt_policy_tier0_gateway.test.path
+  enforcement_point_path = "${data.nsxt_policy_site.test.path}/enforcement-points/default"
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"])
+}
@@ -423,9 +423,9 @@ func resourceNsxtPolicyTier0GatewayReadBGPConfig(d *schema.ResourceData, connect
 	return d.Set("bgp_config", bgpConfigs)
 }
 
-func getPolicyVRFConfigFromSchema(d *schema.ResourceData) *model.Tier0VrfConfig {
+func getPolicyVRFConfigFromSchema(d *schema.ResourceData, m interface{}) *model.Tier0VrfConfig {
 
-	if nsxVersionLower("3.0.0") {
+	if nsxVersionLower(m, "3.0.0") {
 		// VRF Lite is supported from 3.0.0 onwards
 		return nil
 	}
@@ -525,7 +525,7 @@ func resourceNsxtPolicyTier0GatewayExists(id string, connector *client.RestConne
 	return false, logAPIError("Error retrieving Tier0", err)
 }
 
-func resourceNsxtPolicyTier0GatewayBGPConfigSchemaToStruct(cfg interface{}, isVrf bool, gwID string) model.BgpRoutingConfig {
+func resourceNsxtPolicyTier0GatewayBGPConfigSchemaToStruct(d *schema.ResourceData, cfg interface{}, isVrf bool, gwID string) model.BgpRoutingConfig {
 	cfgMap := cfg.(map[string]interface{})
 	revision := int64(cfgMap["revision"].(int))
 	ecmp := cfgMap["ecmp"].(bool)
@@ -592,12 +592,12 @@ func resourceNsxtPolicyTier0GatewayBGPConfigSchemaToStruct(cfg interface{}, isVr
 
 	if isVrf {
 		// backend complains if the below config appears on VRF gateway.
-		// We print a warning if property differs from default
+		// We report a warning if property differs from default
 		if localAsNum != "" {
-			log.Printf("[WARNING] BGP setting local_as_num is not applicable for VRF gateway %s, and will be ignored", gwID)
+			addResourceWarning(d, "BGP setting local_as_num is ignored", fmt.Sprintf("BGP setting local_as_num is not applicable for VRF gateway %s, and will be ignored", gwID))
 		}
 		if (restartMode != model.BgpGracefulRestartConfig_MODE_HELPER_ONLY) || (restartTimer != int64(policyBGPGracefulRestartStaleRouteTimerDefault)) || (staleTimer != int64(policyBGPGracefulRestartStaleRouteTimerDefault)) {
-			log.Printf("[WARNING] BGP graceful restart settings are not applicable for VRF gateway %s, and will be ignored", gwID)
+			addResourceWarning(d, "BGP graceful restart settings are ignored", fmt.Sprintf("BGP graceful restart settings are not applicable for VRF gateway %s, and will be ignored", gwID))
 		}
 	} else {
 		routeStruct.InterSrIbgp = &interSrIbgp
@@ -609,7 +609,7 @@ func resourceNsxtPolicyTier0GatewayBGPConfigSchemaToStruct(cfg interface{}, isVr
 	return routeStruct
 }
 
-func initSingleTier0GatewayLocaleService(d *schema.ResourceData, children []*data.StructValue, connector *client.RestConnector) (*data.StructValue, error) {
+func initSingleTier0GatewayLocaleService(d *schema.ResourceData, m interface{}, children []*data.StructValue, connector *client.RestConnector) (*data.StructValue, error) {
 
 	edgeClusterPath := d.Get("edge_cluster_path").(string)
 	var serviceStruct *model.LocaleServices
@@ -632,7 +632,7 @@ func initSingleTier0GatewayLocaleService(d *schema.ResourceData, children []*dat
 	}
 
 	redistributionConfigs := d.Get("redistribution_config").([]interface{})
-	setLocaleServiceRedistributionConfig(redistributionConfigs, serviceStruct)
+	setLocaleServiceRedistributionConfig(m, redistributionConfigs, serviceStruct)
 
 	serviceStruct.EdgeClusterPath = &edgeClusterPath
 	if len(children) > 0 {
@@ -680,7 +680,7 @@ func initPolicyTier0ChildBgpConfig(config *model.BgpRoutingConfig) (*data.Struct
 	return dataValue.(*data.StructValue), nil
 }
 
-func policyTier0GatewayResourceToInfraStruct(d *schema.ResourceData, connector *client.RestConnector, isGlobalManager bool, id string) (model.Infra, error) {
+func policyTier0GatewayResourceToInfraStruct(d *schema.ResourceData, m interface{}, connector *client.RestConnector, isGlobalManager bool, id string) (model.Infra, error) {
 	var infraChildren, gwChildren, lsChildren []*data.StructValue
 	var infraStruct model.Infra
 	converter := bindings.NewTypeConverter()
@@ -698,7 +698,7 @@ func policyTier0GatewayResourceToInfraStruct(d *schema.ResourceData, connector *
 	internalSubnets := interfaceListToStringList(d.Get("internal_transit_subnets").([]interface{}))
 	transitSubnets := interfaceListToStringList(d.Get("transit_subnets").([]interface{}))
 	ipv6ProfilePaths := getIpv6ProfilePathsFromSchema(d)
-	vrfConfig := getPolicyVRFConfigFromSchema(d)
+	vrfConfig := getPolicyVRFConfigFromSchema(d, m)
 	dhcpPath := d.Get("dhcp_config_path").(string)
 	rdAdminAddress := d.Get("rd_admin_address").(string)
 	rdAdminField := &rdAdminAddress
@@ -724,7 +724,7 @@ func policyTier0GatewayResourceToInfraStruct(d *schema.ResourceData, connector *
 		VrfConfig:              vrfConfig,
 	}
 
-	if nsxVersionHigherOrEqual("3.0.0") {
+	if nsxVersionHigherOrEqual(m, "3.0.0") {
 		t0Struct.RdAdminField = rdAdminField
 	}
 
@@ -747,7 +747,7 @@ func policyTier0GatewayResourceToInfraStruct(d *schema.ResourceData, connector *
 	bgpConfig := d.Get("bgp_config").([]interface{})
 	if len(bgpConfig) > 0 && !isGlobalManager {
 		// For Global Manager BGP is defined as separate resource
-		routingConfigStruct := resourceNsxtPolicyTier0GatewayBGPConfigSchemaToStruct(bgpConfig[0], vrfConfig != nil, id)
+		routingConfigStruct := resourceNsxtPolicyTier0GatewayBGPConfigSchemaToStruct(d, bgpConfig[0], vrfConfig != nil, id)
 		structValue, err := initPolicyTier0ChildBgpConfig(&routingConfigStruct)
 		if err != nil {
 			return infraStruct, err
@@ -771,7 +771,7 @@ func policyTier0GatewayResourceToInfraStruct(d *schema.ResourceData, connector *
 			}
 
 			var err error
-			dataValue, err := initSingleTier0GatewayLocaleService(d, lsChildren, connector)
+			dataValue, err := initSingleTier0GatewayLocaleService(d, m, lsChildren, connector)
 			if err != nil {
 				return infraStruct, err
 			}
@@ -781,7 +781,7 @@ func policyTier0GatewayResourceToInfraStruct(d *schema.ResourceData, connector *
 		// Global Manager
 		if d.HasChange("locale_service") {
 			// Update localse services only if configuration changed
-			localeServices, err := initGatewayLocaleServices(d, connector, listPolicyTier0GatewayLocaleServices)
+			localeServices, err := initGatewayLocaleServices(d, m, connector, listPolicyTier0GatewayLocaleServices)
 			if err != nil {
 				return infraStruct, err
 			}
@@ -827,7 +827,7 @@ func resourceNsxtPolicyTier0GatewayCreate(d *schema.ResourceData, m interface{})
 		return err
 	}
 
-	obj, err := policyTier0GatewayResourceToInfraStruct(d, connector, isGlobalManager, id)
+	obj, err := policyTier0GatewayResourceToInfraStruct(d, m, connector, isGlobalManager, id)
 	if err != nil {
 		return err
 	}
@@ -891,7 +891,7 @@ func resourceNsxtPolicyTier0GatewayRead(d *schema.ResourceData, m interface{}) e
 	d.Set("internal_transit_subnets", obj.InternalTransitSubnets)
 	d.Set("transit_subnets", obj.TransitSubnets)
 	d.Set("revision", obj.Revision)
-	if nsxVersionHigherOrEqual("3.0.0") {
+	if nsxVersionHigherOrEqual(m, "3.0.0") {
 		d.Set("rd_admin_address", obj.RdAdminField)
 	}
 	vrfErr := setPolicyVRFConfigInSchema(d, obj.VrfConfig)
@@ -956,13 +956,13 @@ func resourceNsxtPolicyTier0GatewayRead(d *schema.ResourceData, m interface{}) e
 
 	err = setIpv6ProfilePathsInSchema(d, obj.Ipv6ProfilePaths)
 	if err != nil {
-		return fmt.Errorf("Failed to get Tier0 %s ipv6 profiles: %v", *obj.Id, err)
+		return fmt.Errorf("Failed to get Tier0 %s ipv6 profiles: %w", *obj.Id, err)
 	}
 
 	if isGlobalManager {
 		err = setPolicyGatewayIntersiteConfigInSchema(d, obj.IntersiteConfig)
 		if err != nil {
-			return fmt.Errorf("Failed to get Tier1 %s interset config: %v", *obj.Id, err)
+			return fmt.Errorf("Failed to get Tier1 %s interset config: %w", *obj.Id, err)
 		}
 	}
 
@@ -982,7 +982,7 @@ func resourceNsxtPolicyTier0GatewayUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error obtaining Tier0 ID")
 	}
 
-	obj, err := policyTier0GatewayResourceToInfraStruct(d, connector, isGlobalManager, id)
+	obj, err := policyTier0GatewayResourceToInfraStruct(d, m, connector, isGlobalManager, id)
 	if err != nil {
 		return err
 	}
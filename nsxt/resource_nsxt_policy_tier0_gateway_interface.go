@@ -144,7 +144,7 @@ func getGatewayInterfaceOspfSchema() *schema.Schema {
 }
 
 func gatewayInterfaceVersionDepenantSet(d *schema.ResourceData, m interface{}, obj *model.Tier0Interface) error {
-	if nsxVersionLower("3.0.0") {
+	if nsxVersionLower(m, "3.0.0") {
 		return nil
 	}
 	interfaceType := d.Get("type").(string)
@@ -43,6 +43,11 @@ var poolAllocationValues = []string{
 	model.Tier1_POOL_ALLOCATION_LB_XLARGE,
 }
 
+var policyTier1GatewayPathReferences = []policyPathReference{
+	{attribute: "edge_cluster_path", resourceTypes: []string{"PolicyEdgeCluster"}},
+	{attribute: "tier0_path", resourceTypes: []string{"Tier0"}},
+}
+
 func resourceNsxtPolicyTier1Gateway() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNsxtPolicyTier1GatewayCreate,
@@ -52,6 +57,7 @@ func resourceNsxtPolicyTier1Gateway() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: policyPathsCustomizeDiff(policyTier1GatewayPathReferences),
 
 		Schema: map[string]*schema.Schema{
 			"nsx_id":            getNsxIDSchema(),
@@ -214,7 +220,7 @@ func getPolicyTier1GatewayLocaleServiceEntry(gwID string, connector *client.Rest
 	// List all the locale services
 	objList, errList := listPolicyTier1GatewayLocaleServices(connector, gwID, false)
 	if errList != nil {
-		return nil, fmt.Errorf("Error while reading Tier1 %v locale-services: %v", gwID, err)
+		return nil, fmt.Errorf("Error while reading Tier1 %v locale-services: %w", gwID, errList)
 	}
 	for _, objInList := range objList {
 		// Find the one with the edge cluster path
@@ -317,8 +323,8 @@ func resourceNsxtPolicyTier1GatewaySetQos(d *schema.ResourceData, obj *model.Tie
 
 }
 
-func resourceNsxtPolicyTier1GatewaySetVersionDependentAttrs(d *schema.ResourceData, obj *model.Tier1) {
-	if nsxVersionLower("3.0.0") {
+func resourceNsxtPolicyTier1GatewaySetVersionDependentAttrs(d *schema.ResourceData, m interface{}, obj *model.Tier1) {
+	if nsxVersionLower(m, "3.0.0") {
 		return
 	}
 
@@ -360,7 +366,7 @@ func initSingleTier1GatewayLocaleService(d *schema.ResourceData, connector *clie
 	return initChildLocaleService(serviceStruct, false)
 }
 
-func policyTier1GatewayResourceToInfraStruct(d *schema.ResourceData, connector *client.RestConnector, id string, isGlobalManager bool) (model.Infra, error) {
+func policyTier1GatewayResourceToInfraStruct(d *schema.ResourceData, m interface{}, connector *client.RestConnector, id string, isGlobalManager bool) (model.Infra, error) {
 	var infraChildren, gwChildren []*data.StructValue
 	var infraStruct model.Infra
 	converter := bindings.NewTypeConverter()
@@ -410,7 +416,7 @@ func policyTier1GatewayResourceToInfraStruct(d *schema.ResourceData, connector *
 		obj.Revision = &revision
 	}
 
-	resourceNsxtPolicyTier1GatewaySetVersionDependentAttrs(d, &obj)
+	resourceNsxtPolicyTier1GatewaySetVersionDependentAttrs(d, m, &obj)
 
 	if isGlobalManager {
 		intersiteConfig := getPolicyGatewayIntersiteConfigFromSchema(d)
@@ -430,7 +436,7 @@ func policyTier1GatewayResourceToInfraStruct(d *schema.ResourceData, connector *
 	if isGlobalManager {
 		if d.HasChange("locale_service") {
 			// Update localse services only if configuration changed
-			localeServices, err := initGatewayLocaleServices(d, connector, listPolicyTier1GatewayLocaleServices)
+			localeServices, err := initGatewayLocaleServices(d, m, connector, listPolicyTier1GatewayLocaleServices)
 			if err != nil {
 				return infraStruct, err
 			}
@@ -471,7 +477,7 @@ func resourceNsxtPolicyTier1GatewayCreate(d *schema.ResourceData, m interface{})
 		return err
 	}
 
-	obj, err := policyTier1GatewayResourceToInfraStruct(d, connector, id, isPolicyGlobalManager(m))
+	obj, err := policyTier1GatewayResourceToInfraStruct(d, m, connector, id, isPolicyGlobalManager(m))
 	if err != nil {
 		return err
 	}
@@ -587,18 +593,18 @@ func resourceNsxtPolicyTier1GatewayRead(d *schema.ResourceData, m interface{}) e
 
 	err = setAdvRulesInSchema(d, obj.RouteAdvertisementRules)
 	if err != nil {
-		return fmt.Errorf("Error during Tier1 advertisement rules set in schema: %v", err)
+		return fmt.Errorf("Error during Tier1 advertisement rules set in schema: %w", err)
 	}
 
 	err = setIpv6ProfilePathsInSchema(d, obj.Ipv6ProfilePaths)
 	if err != nil {
-		return fmt.Errorf("Failed to get Tier1 %s ipv6 profiles: %v", *obj.Id, err)
+		return fmt.Errorf("Failed to get Tier1 %s ipv6 profiles: %w", *obj.Id, err)
 	}
 
 	if isGlobalManager {
 		err = setPolicyGatewayIntersiteConfigInSchema(d, obj.IntersiteConfig)
 		if err != nil {
-			return fmt.Errorf("Failed to get Tier1 %s interset config: %v", *obj.Id, err)
+			return fmt.Errorf("Failed to get Tier1 %s interset config: %w", *obj.Id, err)
 		}
 	}
 
@@ -613,7 +619,7 @@ func resourceNsxtPolicyTier1GatewayUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error obtaining Tier1 id")
 	}
 
-	obj, err := policyTier1GatewayResourceToInfraStruct(d, connector, id, isPolicyGlobalManager(m))
+	obj, err := policyTier1GatewayResourceToInfraStruct(d, m, connector, id, isPolicyGlobalManager(m))
 	if err != nil {
 		return err
 	}
@@ -155,7 +155,7 @@ func resourceNsxtPolicyTier1GatewayInterfaceCreate(d *schema.ResourceData, m int
 		obj.Mtu = &mtu
 	}
 
-	if nsxVersionHigherOrEqual("3.0.0") {
+	if nsxVersionHigherOrEqual(m, "3.0.0") {
 		urpfMode := d.Get("urpf_mode").(string)
 		obj.UrpfMode = &urpfMode
 	}
@@ -291,7 +291,7 @@ func resourceNsxtPolicyTier1GatewayInterfaceUpdate(d *schema.ResourceData, m int
 		obj.Mtu = &mtu
 	}
 
-	if nsxVersionHigherOrEqual("3.0.0") {
+	if nsxVersionHigherOrEqual(m, "3.0.0") {
 		urpfMode := d.Get("urpf_mode").(string)
 		obj.UrpfMode = &urpfMode
 	}
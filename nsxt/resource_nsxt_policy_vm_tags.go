@@ -305,7 +305,7 @@ func resourceNsxtPolicyVMTagsRead(d *schema.ResourceData, m interface{}) error {
 
 	vm, err := findNsxtPolicyVMByID(connector, vmID, m)
 	if err != nil {
-		return fmt.Errorf("Error during Virtual Machine retrieval: %v", err)
+		return fmt.Errorf("Error during Virtual Machine retrieval: %w", err)
 	}
 
 	setPolicyTagsInSchema(d, vm.Tags)
@@ -324,7 +324,7 @@ func resourceNsxtPolicyVMTagsCreate(d *schema.ResourceData, m interface{}) error
 
 	vm, err := findNsxtPolicyVMByID(connector, instanceID, m)
 	if err != nil {
-		return fmt.Errorf("Error finding Virtual Machine: %v", err)
+		return fmt.Errorf("Error finding Virtual Machine: %w", err)
 	}
 
 	tags := getPolicyTagsFromSchema(d)
@@ -359,7 +359,7 @@ func resourceNsxtPolicyVMTagsDelete(d *schema.ResourceData, m interface{}) error
 
 	vm, err := findNsxtPolicyVMByID(connector, instanceID, m)
 	if err != nil {
-		return fmt.Errorf("Error finding Virtual Machine: %v", err)
+		return fmt.Errorf("Error finding Virtual Machine: %w", err)
 	}
 
 	tags := make([]model.Tag, 0)
@@ -166,7 +166,7 @@ func setQosRateShaperInSchema(d *schema.ResourceData, shaperConf []manager.QosBa
 			shapers = append(shapers, elem)
 			err := d.Set(schemaName, shapers)
 			if err != nil {
-				log.Printf("[WARNING] Failed to set shapers in schema: %v", err)
+				addResourceWarning(d, "Failed to set shapers in schema", err.Error())
 			}
 		}
 	}
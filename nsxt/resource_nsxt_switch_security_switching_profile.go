@@ -163,7 +163,7 @@ func setSwitchSecurityRateLimitsInSchema(d *schema.ResourceData, rateLimitConf *
 	limits = append(limits, elem)
 	err := d.Set("rate_limits", limits)
 	if err != nil {
-		log.Printf("[WARNING] Failed to set rate limits in schema: %v", err)
+		addResourceWarning(d, "Failed to set rate limits in schema", err.Error())
 	}
 }
 
@@ -106,7 +106,7 @@ func resourceNsxtVlanLogicalSwitchCreate(d *schema.ResourceData, m interface{})
 	}
 
 	toleratePartialSuccess := getCommonProviderConfig(m.(nsxtClients)).ToleratePartialSuccess
-	err = resourceNsxtLogicalSwitchVerifyRealization(d, nsxClient, &logicalSwitch, toleratePartialSuccess)
+	err = resourceNsxtLogicalSwitchVerifyRealization(d, m, nsxClient, &logicalSwitch, toleratePartialSuccess)
 
 	if err != nil {
 		return err
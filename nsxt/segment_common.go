@@ -278,6 +278,15 @@ func getPolicyCommonSegmentSchema(vlanRequired bool, isFixed bool) map[string]*s
 			Optional:    true,
 		},
 		"dhcp_config_path": getPolicyPathSchema(false, false, "Policy path to DHCP server or relay configuration to use for subnets configured on this segment"),
+		"metadata_proxy_paths": {
+			Type:        schema.TypeList,
+			Description: "Policy paths to metadata proxies attached to this segment",
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validatePolicyPath(),
+			},
+		},
 		"transport_zone_path": {
 			Type:         schema.TypeString,
 			Description:  "Policy path to the transport zone",
@@ -438,8 +447,8 @@ func getDhcpOptsFromMap(dhcpConfig map[string]interface{}) *model.DhcpV4Options
 
 }
 
-func getSegmentSubnetDhcpConfigFromSchema(schemaConfig map[string]interface{}) (*data.StructValue, error) {
-	if nsxVersionLower("3.0.0") {
+func getSegmentSubnetDhcpConfigFromSchema(m interface{}, schemaConfig map[string]interface{}) (*data.StructValue, error) {
+	if nsxVersionLower(m, "3.0.0") {
 		return nil, nil
 	}
 
@@ -643,7 +652,7 @@ func nsxtPolicySegmentAddGatewayToInfraStruct(d *schema.ResourceData, dataValue
 	return dataValue1.(*data.StructValue), nil
 }
 
-func policySegmentResourceToInfraStruct(id string, d *schema.ResourceData, isVlan bool, isFixed bool, isGlobalManager bool) (model.Infra, error) {
+func policySegmentResourceToInfraStruct(id string, d *schema.ResourceData, m interface{}, isVlan bool, isFixed bool, isGlobalManager bool) (model.Infra, error) {
 	// Read the rest of the configured parameters
 	var infraChildren []*data.StructValue
 
@@ -653,6 +662,7 @@ func policySegmentResourceToInfraStruct(id string, d *schema.ResourceData, isVla
 	domainName := d.Get("domain_name").(string)
 	tzPath := d.Get("transport_zone_path").(string)
 	dhcpConfigPath := d.Get("dhcp_config_path").(string)
+	metadataProxyPaths := interfaceListToStringList(d.Get("metadata_proxy_paths").([]interface{}))
 	revision := int64(d.Get("revision").(int))
 	resourceType := "Segment"
 
@@ -677,9 +687,13 @@ func policySegmentResourceToInfraStruct(id string, d *schema.ResourceData, isVla
 	if tzPath != "" {
 		obj.TransportZonePath = &tzPath
 	}
-	if dhcpConfigPath != "" && nsxVersionHigherOrEqual("3.0.0") {
+	if dhcpConfigPath != "" && nsxVersionHigherOrEqual(m, "3.0.0") {
 		obj.DhcpConfigPath = &dhcpConfigPath
 	}
+	if (len(metadataProxyPaths) > 0 || d.HasChange("metadata_proxy_paths")) && nsxVersionHigherOrEqual(m, "3.0.0") {
+		// Empty list needs to be sent explicitly in order to detach metadata proxies
+		obj.MetadataProxyPaths = append([]string{}, metadataProxyPaths...)
+	}
 
 	var vlanIds []string
 	var subnets []interface{}
@@ -719,7 +733,7 @@ func policySegmentResourceToInfraStruct(id string, d *schema.ResourceData, isVla
 				GatewayAddress: &gwAddr,
 				Network:        &network,
 			}
-			config, err := getSegmentSubnetDhcpConfigFromSchema(subnetMap)
+			config, err := getSegmentSubnetDhcpConfigFromSchema(m, subnetMap)
 			if err != nil {
 				return model.Infra{}, err
 			}
@@ -751,13 +765,13 @@ func policySegmentResourceToInfraStruct(id string, d *schema.ResourceData, isVla
 			advConfigStruct.Connectivity = &connectivity
 		}
 
-		if nsxVersionHigherOrEqual("3.0.0") {
+		if nsxVersionHigherOrEqual(m, "3.0.0") {
 			teamingPolicy := advConfigMap["uplink_teaming_policy"].(string)
 			if teamingPolicy != "" {
 				advConfigStruct.UplinkTeamingPolicyName = &teamingPolicy
 			}
 
-			if nsxVersionHigherOrEqual("3.1.0") {
+			if nsxVersionHigherOrEqual(m, "3.1.0") {
 				urpfMode := advConfigMap["urpf_mode"].(string)
 				advConfigStruct.UrpfMode = &urpfMode
 			}
@@ -1285,6 +1299,7 @@ func nsxtPolicySegmentRead(d *schema.ResourceData, m interface{}, isVlan bool, i
 		d.Set("connectivity_path", obj.ConnectivityPath)
 	}
 	d.Set("dhcp_config_path", obj.DhcpConfigPath)
+	d.Set("metadata_proxy_paths", obj.MetadataProxyPaths)
 	d.Set("domain_name", obj.DomainName)
 	d.Set("transport_zone_path", obj.TransportZonePath)
 
@@ -1312,7 +1327,7 @@ func nsxtPolicySegmentRead(d *schema.ResourceData, m interface{}, isVlan bool, i
 		if obj.AdvancedConfig.UrpfMode != nil {
 			advConfig["urpf_mode"] = *obj.AdvancedConfig.UrpfMode
 		} else {
-			if nsxVersionLower("3.1.0") {
+			if nsxVersionLower(m, "3.1.0") {
 				// set to default in early versions
 				advConfig["urpf_mode"] = model.SegmentAdvancedConfig_URPF_MODE_STRICT
 			}
@@ -1371,7 +1386,7 @@ func nsxtPolicySegmentCreate(d *schema.ResourceData, m interface{}, isVlan bool,
 		return err
 	}
 
-	obj, err := policySegmentResourceToInfraStruct(id, d, isVlan, isFixed, isPolicyGlobalManager(m))
+	obj, err := policySegmentResourceToInfraStruct(id, d, m, isVlan, isFixed, isPolicyGlobalManager(m))
 	if err != nil {
 		return err
 	}
@@ -1394,7 +1409,7 @@ func nsxtPolicySegmentUpdate(d *schema.ResourceData, m interface{}, isVlan bool,
 		return fmt.Errorf("Error obtaining Segment ID")
 	}
 
-	obj, err := policySegmentResourceToInfraStruct(id, d, isVlan, isFixed, isPolicyGlobalManager(m))
+	obj, err := policySegmentResourceToInfraStruct(id, d, m, isVlan, isFixed, isPolicyGlobalManager(m))
 	if err != nil {
 		return err
 	}
@@ -1458,9 +1473,9 @@ func nsxtPolicySegmentDelete(d *schema.ResourceData, m interface{}, isFixed bool
 		Delay:      1 * time.Second,
 	}
 	if !isFixed {
-		_, err := stateConf.WaitForState()
+		_, err := stateConf.WaitForStateContext(getProviderContext(m))
 		if err != nil {
-			return fmt.Errorf("Failed to get port information for segment %s: %v", id, err)
+			return fmt.Errorf("Failed to get port information for segment %s: %w", id, err)
 		}
 	}
 
@@ -1533,3 +1548,44 @@ func parseSegmentPolicyPath(path string) (bool, string, string) {
 	isT0, gwID := parseGatewayPolicyPath(gwPath)
 	return isT0, gwID, segmentID
 }
+
+func getPolicySegmentRuntimeSegmentPathSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "Policy path of the segment",
+		Required:     true,
+		ValidateFunc: validatePolicyPath(),
+	}
+}
+
+func getPolicySegmentRuntimeEnforcementPointSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "Policy path of the enforcement point",
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validatePolicyPath(),
+	}
+}
+
+// Parse segment path for runtime data sources, and resolve enforcement point
+// Returns tier1 gateway ID for fixed segments, and empty string for infra segments
+func getPolicySegmentRuntimeParams(d *schema.ResourceData, m interface{}) (string, string, string, error) {
+	if isPolicyGlobalManager(m) {
+		return "", "", "", localManagerOnlyError()
+	}
+
+	segmentPath := d.Get("segment_path").(string)
+	isT0, gwID, segmentID := parseSegmentPolicyPath(segmentPath)
+	if isT0 || segmentID == "" {
+		return "", "", "", fmt.Errorf("Runtime information is not available for segment %s", segmentPath)
+	}
+
+	enforcementPointPath := d.Get("enforcement_point_path").(string)
+	if enforcementPointPath == "" {
+		enforcementPointPath = getPolicyEnforcementPointPath(m)
+	}
+	d.Set("enforcement_point_path", enforcementPointPath)
+
+	return gwID, segmentID, enforcementPointPath, nil
+}
@@ -0,0 +1,284 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const nsxtSessionCookieName = "JSESSIONID"
+const nsxtSessionXsrfHeader = "X-XSRF-TOKEN"
+
+// NSX expires sessions that were idle for 30 minutes by default. Session
+// is re-created proactively if it was not used for a bit less than that.
+const nsxtSessionIdleTimeout = 25 * time.Minute
+
+// NSX rejects requests with an expired session with 403 and this error code,
+// while other 403 responses indicate missing permissions
+const nsxtSessionBadXsrfTokenErrorCode = 98
+
+// Authenticated NSX session, shared by MP and policy clients of a single
+// provider instance
+type nsxtSession struct {
+	mutex      sync.Mutex
+	host       string
+	username   string
+	password   string
+	remoteAuth bool
+	transport  http.RoundTripper
+	cookie     string
+	xsrfToken  string
+	lastUsed   time.Time
+}
+
+func newNsxtSession(host string, username string, password string, remoteAuth bool, transport http.RoundTripper) *nsxtSession {
+	session := &nsxtSession{
+		host:       host,
+		username:   username,
+		password:   password,
+		remoteAuth: remoteAuth,
+		transport:  transport,
+	}
+
+	registerShutdownHook(session.destroy)
+
+	return session
+}
+
+// Should be called with mutex locked. Session is created with context of
+// the request that needs it, so that it is cancelled along with it.
+func (s *nsxtSession) createLocked(ctx context.Context) error {
+	var req *http.Request
+	var err error
+	if s.remoteAuth {
+		req, err = http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/session/create", s.host), nil)
+		if err != nil {
+			return err
+		}
+		auth := base64.StdEncoding.EncodeToString([]byte(s.username + ":" + s.password))
+		req.Header.Set("Authorization", fmt.Sprintf("Remote %s", auth))
+	} else {
+		form := url.Values{}
+		form.Set("j_username", s.username)
+		form.Set("j_password", s.password)
+		req, err = http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/session/create", s.host), strings.NewReader(form.Encode()))
+		if err != nil {
+			return err
+		}
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := s.transport.RoundTrip(req)
+	if err != nil {
+		return fmt.Errorf("Failed to create NSX session: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("Unexpected status code %d trying to create NSX session. %s", resp.StatusCode, string(body))
+	}
+
+	s.cookie = ""
+	for _, cookie := range resp.Cookies() {
+		if cookie.Name == nsxtSessionCookieName {
+			s.cookie = fmt.Sprintf("%s=%s", cookie.Name, cookie.Value)
+		}
+	}
+	if s.cookie == "" {
+		return fmt.Errorf("NSX session cookie is missing in session create response")
+	}
+	s.xsrfToken = resp.Header.Get(nsxtSessionXsrfHeader)
+	s.lastUsed = time.Now()
+
+	log.Printf("[DEBUG] Created NSX session for user %s", s.username)
+	return nil
+}
+
+// Returns session cookie and XSRF token, creating the session if needed
+func (s *nsxtSession) getCredentials(ctx context.Context) (string, string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.cookie == "" || time.Since(s.lastUsed) > nsxtSessionIdleTimeout {
+		err := s.createLocked(ctx)
+		if err != nil {
+			return "", "", err
+		}
+	}
+	s.lastUsed = time.Now()
+
+	return s.cookie, s.xsrfToken, nil
+}
+
+// Re-create session that was rejected by NSX, unless it was already
+// re-created by a concurrent request
+func (s *nsxtSession) refreshRejected(ctx context.Context, rejectedCookie string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.cookie != rejectedCookie {
+		return nil
+	}
+
+	log.Printf("[INFO] NSX session was rejected, re-authenticating")
+	return s.createLocked(ctx)
+}
+
+func (s *nsxtSession) destroy() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.cookie == "" {
+		return
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/session/destroy", s.host), nil)
+	if err != nil {
+		return
+	}
+	req.Header.Set("Cookie", s.cookie)
+	req.Header.Set(nsxtSessionXsrfHeader, s.xsrfToken)
+
+	resp, err := s.transport.RoundTrip(req)
+	if err != nil {
+		log.Printf("[WARNING] Failed to destroy NSX session: %v", err)
+		return
+	}
+	resp.Body.Close()
+	s.cookie = ""
+	s.xsrfToken = ""
+	log.Printf("[DEBUG] Destroyed NSX session for user %s", s.username)
+}
+
+// Replaces credentials in outgoing requests with session cookie and XSRF
+// token, and re-authenticates when session is rejected
+type sessionAuthTransport struct {
+	transport http.RoundTripper
+	session   *nsxtSession
+}
+
+func newSessionAuthTransport(transport http.RoundTripper, session *nsxtSession) *sessionAuthTransport {
+	return &sessionAuthTransport{transport: transport, session: session}
+}
+
+func (t *sessionAuthTransport) sessionRequest(req *http.Request, body bool) (*http.Request, string, error) {
+	cookie, xsrfToken, err := t.session.getCredentials(req.Context())
+	if err != nil {
+		return nil, "", err
+	}
+
+	sessionReq := req.Clone(req.Context())
+	if body && req.GetBody != nil {
+		sessionReq.Body, err = req.GetBody()
+		if err != nil {
+			return nil, "", err
+		}
+	}
+	sessionReq.Header.Del("Authorization")
+	sessionReq.Header.Set("Cookie", cookie)
+	if xsrfToken != "" {
+		sessionReq.Header.Set(nsxtSessionXsrfHeader, xsrfToken)
+	}
+
+	return sessionReq, cookie, nil
+}
+
+// MP client creates its own session on initialization, which is served
+// from the shared session instead
+func (t *sessionAuthTransport) sessionCreateResponse(req *http.Request) (*http.Response, error) {
+	cookie, xsrfToken, err := t.session.getCredentials(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	header := make(http.Header)
+	header.Set("Set-Cookie", fmt.Sprintf("%s; Path=/; Secure; HttpOnly", cookie))
+	header.Set(nsxtSessionXsrfHeader, xsrfToken)
+	return &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     header,
+		Body:       ioutil.NopCloser(strings.NewReader("")),
+		Request:    req,
+	}, nil
+}
+
+// Checks whether request was rejected because the session is not valid
+// anymore. Response body is read and replaced, so that it can still be
+// consumed by the caller.
+func isNsxtSessionRejected(resp *http.Response) bool {
+	if resp.StatusCode == http.StatusUnauthorized {
+		return true
+	}
+	if resp.StatusCode != http.StatusForbidden || resp.Body == nil {
+		return false
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return false
+	}
+
+	var apiError struct {
+		ErrorCode int64 `json:"error_code"`
+	}
+	if json.Unmarshal(body, &apiError) != nil {
+		return false
+	}
+	return apiError.ErrorCode == nsxtSessionBadXsrfTokenErrorCode
+}
+
+func (t *sessionAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if strings.HasSuffix(req.URL.Path, "/api/session/create") {
+		return t.sessionCreateResponse(req)
+	}
+
+	sessionReq, cookie, err := t.sessionRequest(req, false)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.transport.RoundTrip(sessionReq)
+	if err != nil || !isNsxtSessionRejected(resp) {
+		return resp, err
+	}
+
+	if req.Body != nil && req.GetBody == nil {
+		// Request body can not be replayed
+		return resp, nil
+	}
+
+	err = t.session.refreshRejected(req.Context(), cookie)
+	if err != nil {
+		log.Printf("[ERROR] Failed to re-create NSX session: %v", err)
+		return resp, nil
+	}
+
+	retryReq, _, err := t.sessionRequest(req, true)
+	if err != nil {
+		return resp, nil
+	}
+
+	resp.Body.Close()
+	log.Printf("[DEBUG] Retrying %s %s with new NSX session", req.Method, req.URL.Path)
+	return t.transport.RoundTrip(retryReq)
+}
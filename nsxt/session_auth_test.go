@@ -0,0 +1,269 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// NSX server that issues sessions s1, s2... and only accepts the latest one.
+// Requests with an expired session are rejected with rejectStatus.
+type testSessionServer struct {
+	server       *httptest.Server
+	mutex        sync.Mutex
+	rejectStatus int
+	createCalls  int
+	destroyCalls int
+	apiCalls     int
+	session      int
+	authHeaders  []string
+	bodies       []string
+}
+
+func newTestSessionServer(remoteAuth bool) *testSessionServer {
+	s := &testSessionServer{rejectStatus: http.StatusForbidden}
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+
+		switch r.URL.Path {
+		case "/api/session/create":
+			s.createCalls++
+			if remoteAuth {
+				expected := "Remote " + base64.StdEncoding.EncodeToString([]byte("admin:secret"))
+				if r.Header.Get("Authorization") != expected {
+					w.WriteHeader(http.StatusForbidden)
+					return
+				}
+			} else if err := r.ParseForm(); err != nil || r.Form.Get("j_username") != "admin" || r.Form.Get("j_password") != "secret" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			s.session++
+			http.SetCookie(w, &http.Cookie{Name: nsxtSessionCookieName, Value: fmt.Sprintf("s%d", s.session)})
+			w.Header().Set(nsxtSessionXsrfHeader, fmt.Sprintf("x%d", s.session))
+		case "/api/session/destroy":
+			s.destroyCalls++
+		default:
+			s.apiCalls++
+			s.authHeaders = append(s.authHeaders, r.Header.Get("Authorization"))
+			body, _ := ioutil.ReadAll(r.Body)
+			s.bodies = append(s.bodies, string(body))
+			cookie, err := r.Cookie(nsxtSessionCookieName)
+			if err != nil || cookie.Value != fmt.Sprintf("s%d", s.session) || r.Header.Get(nsxtSessionXsrfHeader) != fmt.Sprintf("x%d", s.session) {
+				w.WriteHeader(s.rejectStatus)
+				if s.rejectStatus == http.StatusForbidden {
+					fmt.Fprintf(w, `{"error_code": %d, "error_message": "Bad XSRF token"}`, nsxtSessionBadXsrfTokenErrorCode)
+				}
+				return
+			}
+			if r.URL.Path == "/policy/api/v1/infra/forbidden" {
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprint(w, `{"error_code": 403, "error_message": "Permission denied"}`)
+			}
+		}
+	}))
+	return s
+}
+
+// Invalidates current session, as NSX does on idle timeout or restart
+func (s *testSessionServer) expireSession() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.session++
+}
+
+func (s *testSessionServer) newTransport(remoteAuth bool) *sessionAuthTransport {
+	session := newNsxtSession(s.server.URL, "admin", "secret", remoteAuth, s.server.Client().Transport)
+	return newSessionAuthTransport(s.server.Client().Transport, session)
+}
+
+func testSessionRoundTrip(t *testing.T, transport http.RoundTripper, req *http.Request) int {
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	return resp.StatusCode
+}
+
+func TestSessionAuthTransportReusesSession(t *testing.T) {
+	for _, remoteAuth := range []bool{false, true} {
+		s := newTestSessionServer(remoteAuth)
+		transport := s.newTransport(remoteAuth)
+
+		for i := 0; i < 2; i++ {
+			req, _ := http.NewRequest("GET", s.server.URL+"/policy/api/v1/infra", nil)
+			req.SetBasicAuth("admin", "secret")
+			status := testSessionRoundTrip(t, transport, req)
+			if status != http.StatusOK {
+				t.Errorf("remote auth %v: expected status %d, got %d", remoteAuth, http.StatusOK, status)
+			}
+		}
+
+		if s.createCalls != 1 || s.apiCalls != 2 {
+			t.Errorf("remote auth %v: expected single session for 2 calls, got %d sessions and %d calls", remoteAuth, s.createCalls, s.apiCalls)
+		}
+		for _, header := range s.authHeaders {
+			if header != "" {
+				t.Errorf("remote auth %v: expected credentials to be removed from request, got %s", remoteAuth, header)
+			}
+		}
+		s.server.Close()
+	}
+}
+
+func TestSessionAuthTransportRecreatesRejectedSession(t *testing.T) {
+	for _, rejectStatus := range []int{http.StatusUnauthorized, http.StatusForbidden} {
+		s := newTestSessionServer(false)
+		s.rejectStatus = rejectStatus
+		transport := s.newTransport(false)
+
+		req, _ := http.NewRequest("GET", s.server.URL+"/api/v1/node", nil)
+		testSessionRoundTrip(t, transport, req)
+
+		s.expireSession()
+		req, _ = http.NewRequest("PATCH", s.server.URL+"/policy/api/v1/infra", strings.NewReader("payload"))
+		status := testSessionRoundTrip(t, transport, req)
+		s.server.Close()
+		if status != http.StatusOK {
+			t.Errorf("status %d: expected status %d after session re-creation, got %d", rejectStatus, http.StatusOK, status)
+		}
+		if s.createCalls != 2 || s.apiCalls != 3 {
+			t.Errorf("status %d: expected session re-creation and retry, got %d sessions and %d calls", rejectStatus, s.createCalls, s.apiCalls)
+		}
+		if len(s.bodies) != 3 || s.bodies[1] != "payload" || s.bodies[2] != "payload" {
+			t.Errorf("status %d: expected request body to be replayed, got %v", rejectStatus, s.bodies)
+		}
+	}
+}
+
+func TestSessionAuthTransportPermissionDenied(t *testing.T) {
+	s := newTestSessionServer(false)
+	defer s.server.Close()
+	transport := s.newTransport(false)
+
+	req, _ := http.NewRequest("GET", s.server.URL+"/policy/api/v1/infra/forbidden", nil)
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusForbidden || !strings.Contains(string(body), "Permission denied") {
+		t.Errorf("Expected permission error to be returned as is, got status %d and body %s", resp.StatusCode, body)
+	}
+	if s.createCalls != 1 || s.apiCalls != 1 {
+		t.Errorf("Expected no session re-creation, got %d sessions and %d calls", s.createCalls, s.apiCalls)
+	}
+}
+
+func TestSessionAuthTransportNonReplayableBody(t *testing.T) {
+	s := newTestSessionServer(false)
+	defer s.server.Close()
+	transport := s.newTransport(false)
+
+	req, _ := http.NewRequest("GET", s.server.URL+"/api/v1/node", nil)
+	testSessionRoundTrip(t, transport, req)
+
+	s.expireSession()
+	req, _ = http.NewRequest("PATCH", s.server.URL+"/policy/api/v1/infra", ioutil.NopCloser(strings.NewReader("payload")))
+	status := testSessionRoundTrip(t, transport, req)
+	if status != http.StatusForbidden {
+		t.Errorf("Expected status %d to be returned as is, got %d", http.StatusForbidden, status)
+	}
+	if s.createCalls != 1 || s.apiCalls != 2 {
+		t.Errorf("Expected no retry, got %d sessions and %d calls", s.createCalls, s.apiCalls)
+	}
+}
+
+func TestSessionAuthTransportIdleTimeout(t *testing.T) {
+	s := newTestSessionServer(false)
+	defer s.server.Close()
+	transport := s.newTransport(false)
+
+	req, _ := http.NewRequest("GET", s.server.URL+"/api/v1/node", nil)
+	testSessionRoundTrip(t, transport, req)
+
+	transport.session.lastUsed = time.Now().Add(-nsxtSessionIdleTimeout - time.Minute)
+	req, _ = http.NewRequest("GET", s.server.URL+"/api/v1/node", nil)
+	testSessionRoundTrip(t, transport, req)
+
+	if s.createCalls != 2 || s.apiCalls != 2 {
+		t.Errorf("Expected idle session to be re-created proactively, got %d sessions and %d calls", s.createCalls, s.apiCalls)
+	}
+}
+
+func TestSessionAuthTransportSessionCreate(t *testing.T) {
+	s := newTestSessionServer(false)
+	defer s.server.Close()
+	transport := s.newTransport(false)
+
+	// MP client session creation is served from the shared session
+	for i := 0; i < 2; i++ {
+		req, _ := http.NewRequest("POST", s.server.URL+"/api/session/create", nil)
+		resp, err := transport.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		resp.Body.Close()
+
+		cookies := resp.Cookies()
+		if len(cookies) != 1 || cookies[0].Name != nsxtSessionCookieName || cookies[0].Value != "s1" {
+			t.Errorf("Expected shared session cookie, got %v", cookies)
+		}
+		if resp.Header.Get(nsxtSessionXsrfHeader) != "x1" {
+			t.Errorf("Expected shared XSRF token, got %s", resp.Header.Get(nsxtSessionXsrfHeader))
+		}
+	}
+
+	if s.createCalls != 1 {
+		t.Errorf("Expected single session, got %d", s.createCalls)
+	}
+}
+
+func TestNsxtSessionCreateFailure(t *testing.T) {
+	s := newTestSessionServer(false)
+	defer s.server.Close()
+	session := newNsxtSession(s.server.URL, "admin", "wrong", false, s.server.Client().Transport)
+	transport := newSessionAuthTransport(s.server.Client().Transport, session)
+
+	req, _ := http.NewRequest("GET", s.server.URL+"/api/v1/node", nil)
+	_, err := transport.RoundTrip(req)
+	if err == nil || !strings.Contains(err.Error(), "403") {
+		t.Errorf("Expected session creation error, got %v", err)
+	}
+	if s.apiCalls != 0 {
+		t.Errorf("Expected no API calls without session, got %d", s.apiCalls)
+	}
+}
+
+func TestNsxtSessionDestroy(t *testing.T) {
+	s := newTestSessionServer(false)
+	defer s.server.Close()
+	transport := s.newTransport(false)
+
+	// Session that was never created is not destroyed
+	transport.session.destroy()
+	if s.destroyCalls != 0 {
+		t.Errorf("Expected no session destroy, got %d", s.destroyCalls)
+	}
+
+	req, _ := http.NewRequest("GET", s.server.URL+"/api/v1/node", nil)
+	testSessionRoundTrip(t, transport, req)
+	transport.session.destroy()
+	transport.session.destroy()
+	if s.destroyCalls != 1 || transport.session.cookie != "" {
+		t.Errorf("Expected session to be destroyed once, got %d destroy calls", s.destroyCalls)
+	}
+}
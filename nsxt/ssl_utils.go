@@ -4,6 +4,12 @@
 package nsxt
 
 import (
+	"crypto/sha256"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -49,3 +55,36 @@ func getCertificateChainDepthSchema() *schema.Schema {
 		Default:     3,
 	}
 }
+
+func getPemFingerprint(pemEncoded string) string {
+	// Fingerprint is calculated over the first PEM block, which is the leaf certificate
+	// in case of a chain
+	block, _ := pem.Decode([]byte(normalizePem(pemEncoded)))
+	if block == nil {
+		return ""
+	}
+
+	sum := sha256.Sum256(block.Bytes)
+	hexBytes := make([]string, 0, len(sum))
+	for _, b := range sum {
+		hexBytes = append(hexBytes, fmt.Sprintf("%02X", b))
+	}
+	return strings.Join(hexBytes, ":")
+}
+
+// Returns empty string when NSX did not report the time
+func epochMillisToRFC3339(epoch *int64) string {
+	if epoch == nil || *epoch == 0 {
+		return ""
+	}
+	return time.Unix(0, *epoch*int64(time.Millisecond)).UTC().Format(time.RFC3339)
+}
+
+func normalizePem(pemEncoded string) string {
+	return strings.TrimSpace(strings.ReplaceAll(pemEncoded, "\r\n", "\n"))
+}
+
+func pemDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	// NSX may strip trailing newlines and convert line endings of PEM data
+	return normalizePem(old) == normalizePem(new)
+}
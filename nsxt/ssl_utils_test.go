@@ -0,0 +1,81 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+)
+
+const testPemBlock = "-----BEGIN CERTIFICATE-----\ndGVzdA==\n-----END CERTIFICATE-----\n"
+const testPemChain = testPemBlock + "-----BEGIN CERTIFICATE-----\nb3RoZXI=\n-----END CERTIFICATE-----\n"
+const testPemFingerprint = "9F:86:D0:81:88:4C:7D:65:9A:2F:EA:A0:C5:5A:D0:15:A3:BF:4F:1B:2B:0B:82:2C:D1:5D:6C:15:B0:F0:0A:08"
+
+func TestGetPemFingerprint(t *testing.T) {
+	cases := []struct {
+		name     string
+		pem      string
+		expected string
+	}{
+		{"single block", testPemBlock, testPemFingerprint},
+		{"chain uses leaf", testPemChain, testPemFingerprint},
+		{"leading whitespace", "\n  " + testPemBlock, testPemFingerprint},
+		{"empty", "", ""},
+		{"not pem", "dGVzdA==", ""},
+		{"truncated", "-----BEGIN CERTIFICATE-----\ndGVzdA==\n", ""},
+	}
+
+	for _, tc := range cases {
+		result := getPemFingerprint(tc.pem)
+		if result != tc.expected {
+			t.Errorf("%s: expected fingerprint %q, got %q", tc.name, tc.expected, result)
+		}
+	}
+}
+
+func TestEpochMillisToRFC3339(t *testing.T) {
+	epoch := int64(1609459200000)
+	millis := int64(1609459200999)
+	zero := int64(0)
+	cases := []struct {
+		name     string
+		epoch    *int64
+		expected string
+	}{
+		{"nil", nil, ""},
+		{"zero", &zero, ""},
+		{"seconds", &epoch, "2021-01-01T00:00:00Z"},
+		{"millis truncated", &millis, "2021-01-01T00:00:00Z"},
+	}
+
+	for _, tc := range cases {
+		result := epochMillisToRFC3339(tc.epoch)
+		if result != tc.expected {
+			t.Errorf("%s: expected %q, got %q", tc.name, tc.expected, result)
+		}
+	}
+}
+
+func TestPemDiffSuppress(t *testing.T) {
+	crlf := "-----BEGIN CERTIFICATE-----\r\ndGVzdA==\r\n-----END CERTIFICATE-----\r\n"
+	cases := []struct {
+		name     string
+		old      string
+		new      string
+		expected bool
+	}{
+		{"equal", testPemBlock, testPemBlock, true},
+		{"trailing newline stripped", testPemBlock, testPemBlock + "\n\n", true},
+		{"surrounding whitespace", "  " + testPemBlock, testPemBlock + "  ", true},
+		{"crlf line endings", testPemBlock, crlf, true},
+		{"different content", testPemBlock, testPemChain, false},
+		{"empty and set", "", testPemBlock, false},
+	}
+
+	for _, tc := range cases {
+		result := pemDiffSuppress("pem_encoded", tc.old, tc.new, nil)
+		if result != tc.expected {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.expected, result)
+		}
+	}
+}
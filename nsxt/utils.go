@@ -5,6 +5,8 @@ package nsxt
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
 	"hash/crc32"
 	"log"
@@ -16,12 +18,11 @@ import (
 	api "github.com/vmware/go-vmware-nsxt"
 	"github.com/vmware/go-vmware-nsxt/common"
 	"github.com/vmware/go-vmware-nsxt/manager"
-
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/security"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/search"
 )
 
 var adminStateValues = []string{"UP", "DOWN"}
-var nsxVersion = ""
 
 func interface2StringList(configured []interface{}) []string {
 	vs := make([]string, 0, len(configured))
@@ -146,7 +147,7 @@ func setCustomizedTagsInSchema(d *schema.ResourceData, tags []common.Tag, schema
 	}
 	err := d.Set(schemaName, tagList)
 	if err != nil {
-		log.Printf("[WARNING] Failed to set tag in schema: %v", err)
+		addResourceWarning(d, "Failed to set tag in schema", err.Error())
 	}
 }
 
@@ -504,7 +505,7 @@ func setIPRangesInSchema(d *schema.ResourceData, ranges []manager.IpPoolRange) {
 	}
 	err := d.Set("ip_range", rangeList)
 	if err != nil {
-		log.Printf("[WARNING]: Failed to set ip range in schema: %v", err)
+		addResourceWarning(d, "Failed to set ip range in schema", err.Error())
 	}
 }
 
@@ -526,23 +527,73 @@ func getNSXVersion(nsxClient *api.APIClient) (string, error) {
 	return nodeProperties.NodeVersion, nil
 }
 
-func initNSXVersion(nsxClient *api.APIClient) error {
+func initNSXVersion(clients *nsxtClients) error {
 	var err error
-	nsxVersion, err = getNSXVersion(nsxClient)
+	clients.NsxVersion, err = getNSXVersion(clients.NsxtClient)
 	return err
 }
 
-func initNSXVersionVMC(clients interface{}) {
-	// TODO: find a ireliable way to retrieve NSX version on VMC
-	// For now, we need to determine whether the deployment is 3.0.0 and up, or below
-	// For this purpose, we fire indicator search API (introduced in 3.0.0)
-	nsxVersion = "3.0.0"
+type policyNodeVersion struct {
+	NodeVersion string `json:"node_version"`
+}
+
+// Node version is exposed in policy API namespace, which is also available
+// in VMC environment
+func getPolicyNSXVersion(clients *nsxtClients) (string, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/policy/api/v1/node/version", clients.Host), nil)
+	if err != nil {
+		return "", err
+	}
+
+	if clients.CommonConfig.VmcAuthProcessor != nil {
+		err = clients.CommonConfig.VmcAuthProcessor.Process(req)
+		if err != nil {
+			return "", err
+		}
+	} else if clients.PolicySecurityContext != nil {
+		username, _ := clients.PolicySecurityContext.Property(security.USER_KEY).(string)
+		password, _ := clients.PolicySecurityContext.Property(security.PASSWORD_KEY).(string)
+		req.SetBasicAuth(username, password)
+	}
 
-	connector := getPolicyConnector(clients)
+	resp, err := clients.PolicyHTTPClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("Unexpected status %d while retrieving NSX version", resp.StatusCode)
+	}
+
+	var nodeVersion policyNodeVersion
+	err = json.NewDecoder(resp.Body).Decode(&nodeVersion)
+	if err != nil {
+		return "", fmt.Errorf("Failed to parse NSX version: %v", err)
+	}
+
+	log.Printf("[DEBUG] NSX version is %s", nodeVersion.NodeVersion)
+	return nodeVersion.NodeVersion, nil
+}
+
+func initNSXVersionVMC(clients *nsxtClients) {
+	nsxVersion, err := getPolicyNSXVersion(clients)
+	if err == nil && nsxVersion != "" {
+		clients.NsxVersion = nsxVersion
+		return
+	}
+	log.Printf("[INFO] Failed to retrieve NSX version in VMC environment: %v", err)
+
+	// Older deployments do not expose node version in policy API. In this case
+	// we determine whether the deployment is 3.0.0 and up, or below, by firing
+	// indicator search API (introduced in 3.0.0)
+	clients.NsxVersion = "3.0.0"
+
+	connector := getPolicyConnector(*clients)
 	client := search.NewDefaultQueryClient(connector)
 	var cursor *string
 	query := "resource_type:dummy"
-	_, err := client.List(query, cursor, nil, nil, nil, nil)
+	_, err = client.List(query, cursor, nil, nil, nil, nil)
 	if err == nil {
 		// we are 3.0.0 and above
 		log.Printf("[INFO] Assuming NSX version >= 3.0.0 in VMC environment")
@@ -552,7 +603,7 @@ func initNSXVersionVMC(clients interface{}) {
 	if isNotFoundError(err) {
 		// search API not supported
 		log.Printf("[INFO] Assuming NSX version < 3.0.0 in VMC environment")
-		nsxVersion = "2.5.0"
+		clients.NsxVersion = "2.5.0"
 		return
 	}
 
@@ -560,10 +611,14 @@ func initNSXVersionVMC(clients interface{}) {
 	log.Printf("[ERROR] Failed to determine NSX version in VMC environment: %s", err)
 }
 
-func nsxVersionLower(ver string) bool {
+func getNSXVersionFromClients(clients interface{}) string {
+	return clients.(nsxtClients).NsxVersion
+}
+
+func versionLower(currentVer string, ver string) bool {
 
 	requestedVersion, err1 := version.NewVersion(ver)
-	currentVersion, err2 := version.NewVersion(nsxVersion)
+	currentVersion, err2 := version.NewVersion(currentVer)
 	if err1 != nil || err2 != nil {
 		log.Printf("[ERROR] Failed perform version check for version %s", ver)
 		return true
@@ -571,10 +626,10 @@ func nsxVersionLower(ver string) bool {
 	return currentVersion.LessThan(requestedVersion)
 }
 
-func nsxVersionHigherOrEqual(ver string) bool {
+func versionHigherOrEqual(currentVer string, ver string) bool {
 
 	requestedVersion, err1 := version.NewVersion(ver)
-	currentVersion, err2 := version.NewVersion(nsxVersion)
+	currentVersion, err2 := version.NewVersion(currentVer)
 	if err1 != nil || err2 != nil {
 		log.Printf("[ERROR] Failed perform version check for version %s", ver)
 		return false
@@ -582,6 +637,35 @@ func nsxVersionHigherOrEqual(ver string) bool {
 	return currentVersion.Compare(requestedVersion) >= 0
 }
 
+func nsxVersionLower(m interface{}, ver string) bool {
+	return versionLower(getNSXVersionFromClients(m), ver)
+}
+
+func nsxVersionHigherOrEqual(m interface{}, ver string) bool {
+	return versionHigherOrEqual(getNSXVersionFromClients(m), ver)
+}
+
+func checkMinNsxVersion(m interface{}, minVersion string, objType string) error {
+	clients, ok := m.(nsxtClients)
+	if !ok || clients.NsxVersion == "" {
+		// Version could not be determined
+		return nil
+	}
+
+	if versionLower(clients.NsxVersion, minVersion) {
+		return fmt.Errorf("This %s requires NSX >= %s (current version is %s)", objType, minVersion, clients.NsxVersion)
+	}
+	return nil
+}
+
+// Resources that are introduced in later NSX versions declare their minimum
+// version, so that unsupported configuration is reported during plan
+func nsxVersionCustomizeDiff(minVersion string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		return checkMinNsxVersion(m, minVersion, "resource")
+	}
+}
+
 func resourceNotSupportedError() error {
 	return fmt.Errorf("This resource is not supported with given provider settings")
 }
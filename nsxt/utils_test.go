@@ -122,6 +122,10 @@ func getTestVMSegmentID() string {
 	return os.Getenv("NSXT_TEST_VM_SEGMENT_ID")
 }
 
+func getTestSegmentPortPath() string {
+	return os.Getenv("NSXT_TEST_SEGMENT_PORT_PATH")
+}
+
 func getTestVMName() string {
 	return os.Getenv("NSXT_TEST_VM_NAME")
 }
@@ -0,0 +1,165 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/security"
+)
+
+// Access token is refreshed this long before it expires
+const vmcTokenRefreshMargin = 2 * time.Minute
+
+// Access token obtained from VMC auth service in exchange for the refresh
+// token. Access token expires, hence it is refreshed ahead of expiry, or
+// when it is rejected by NSX.
+type vmcAuthToken struct {
+	mutex        sync.Mutex
+	httpClient   *http.Client
+	authHost     string
+	refreshToken string
+	accessToken  string
+	expiry       time.Time
+}
+
+func newVmcAuthToken(httpClient *http.Client, authHost string, refreshToken string) (*vmcAuthToken, error) {
+	token := vmcAuthToken{
+		httpClient:   httpClient,
+		authHost:     authHost,
+		refreshToken: refreshToken,
+	}
+
+	err := token.refreshLocked()
+	if err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+// Should be called with mutex locked
+func (t *vmcAuthToken) refreshLocked() error {
+	token, err := getAPIToken(t.httpClient, t.authHost, t.refreshToken)
+	if err != nil {
+		return err
+	}
+
+	t.accessToken = token.AccessToken
+	t.expiry = time.Time{}
+	if token.ExpiresIn > 0 {
+		t.expiry = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+	log.Printf("[DEBUG] Obtained VMC access token that expires in %d seconds", token.ExpiresIn)
+
+	return nil
+}
+
+func (t *vmcAuthToken) getAccessToken() (string, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if !t.expiry.IsZero() && time.Now().Add(vmcTokenRefreshMargin).After(t.expiry) {
+		log.Printf("[INFO] VMC access token is about to expire, refreshing")
+		err := t.refreshLocked()
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return t.accessToken, nil
+}
+
+// Refresh access token that was rejected by NSX, unless it was already
+// refreshed by a concurrent request
+func (t *vmcAuthToken) refreshRejected(rejectedToken string) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.accessToken != rejectedToken {
+		return nil
+	}
+
+	log.Printf("[INFO] VMC access token was rejected, refreshing")
+	return t.refreshLocked()
+}
+
+// Sets current access token in request header, either as bearer token or
+// as CSP auth token, depending on VMC auth mode
+type vmcAuthHeaderProcessor struct {
+	Token  *vmcAuthToken
+	Bearer bool
+}
+
+func newVmcAuthHeaderProcessor(token *vmcAuthToken, bearer bool) *vmcAuthHeaderProcessor {
+	return &vmcAuthHeaderProcessor{Token: token, Bearer: bearer}
+}
+
+func (processor vmcAuthHeaderProcessor) Process(req *http.Request) error {
+	accessToken, err := processor.Token.getAccessToken()
+	if err != nil {
+		return err
+	}
+
+	if processor.Bearer {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", accessToken))
+	} else {
+		req.Header.Set(security.CSP_AUTH_TOKEN_KEY, accessToken)
+	}
+	return nil
+}
+
+func (processor vmcAuthHeaderProcessor) requestToken(req *http.Request) string {
+	if processor.Bearer {
+		return strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+	}
+	return req.Header.Get(security.CSP_AUTH_TOKEN_KEY)
+}
+
+// Request processors have no access to the response, hence retry with
+// refreshed token is performed on transport level
+type vmcAuthRetryTransport struct {
+	transport http.RoundTripper
+	processor *vmcAuthHeaderProcessor
+}
+
+func (t *vmcAuthRetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	if req.Body != nil && req.GetBody == nil {
+		// Request body can not be replayed
+		return resp, nil
+	}
+
+	err = t.processor.Token.refreshRejected(t.processor.requestToken(req))
+	if err != nil {
+		log.Printf("[ERROR] Failed to refresh VMC access token: %v", err)
+		return resp, nil
+	}
+
+	retryReq := req.Clone(req.Context())
+	if req.GetBody != nil {
+		retryReq.Body, err = req.GetBody()
+		if err != nil {
+			return resp, nil
+		}
+	}
+
+	err = t.processor.Process(retryReq)
+	if err != nil {
+		return resp, nil
+	}
+
+	resp.Body.Close()
+	log.Printf("[DEBUG] Retrying %s %s with refreshed VMC access token", req.Method, req.URL.Path)
+	return t.transport.RoundTrip(retryReq)
+}
@@ -0,0 +1,206 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/security"
+)
+
+// Auth service that issues access tokens token-1, token-2... on each exchange
+type testVmcAuthServer struct {
+	server    *httptest.Server
+	calls     int32
+	expiresIn int64
+}
+
+func newTestVmcAuthServer(expiresIn int64) *testVmcAuthServer {
+	authServer := &testVmcAuthServer{expiresIn: expiresIn}
+	authServer.server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := atomic.AddInt32(&authServer.calls, 1)
+		fmt.Fprintf(w, `{"access_token": "token-%d", "expires_in": %d}`, call, authServer.expiresIn)
+	}))
+	return authServer
+}
+
+func (s *testVmcAuthServer) newToken(t *testing.T) *vmcAuthToken {
+	host := strings.TrimPrefix(s.server.URL, "https://")
+	token, err := newVmcAuthToken(s.server.Client(), host, "refresh")
+	if err != nil {
+		t.Fatalf("Failed to obtain access token: %v", err)
+	}
+	return token
+}
+
+// NSX server that only accepts given access token as CSP auth token
+func newTestVmcNsxServer(validToken *string, calls *int32, bodies *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(calls, 1)
+		if bodies != nil {
+			body, _ := ioutil.ReadAll(r.Body)
+			*bodies = append(*bodies, string(body))
+		}
+		if r.Header.Get(security.CSP_AUTH_TOKEN_KEY) != *validToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+}
+
+func testVmcAuthRoundTrip(t *testing.T, transport *vmcAuthRetryTransport, req *http.Request) int {
+	err := transport.processor.Process(req)
+	if err != nil {
+		t.Fatalf("Failed to process request: %v", err)
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	return resp.StatusCode
+}
+
+func TestVmcAuthRetryTransportRefreshesRejectedToken(t *testing.T) {
+	authServer := newTestVmcAuthServer(3600)
+	defer authServer.server.Close()
+	token := authServer.newToken(t)
+
+	// NSX revoked the first token
+	validToken := "token-2"
+	var nsxCalls int32
+	var bodies []string
+	nsxServer := newTestVmcNsxServer(&validToken, &nsxCalls, &bodies)
+	defer nsxServer.Close()
+
+	transport := &vmcAuthRetryTransport{
+		transport: nsxServer.Client().Transport,
+		processor: newVmcAuthHeaderProcessor(token, false),
+	}
+
+	req, _ := http.NewRequest("PUT", nsxServer.URL, strings.NewReader("payload"))
+	status := testVmcAuthRoundTrip(t, transport, req)
+	if status != http.StatusOK {
+		t.Errorf("Expected status %d after token refresh, got %d", http.StatusOK, status)
+	}
+	if authServer.calls != 2 {
+		t.Errorf("Expected 2 token exchanges, got %d", authServer.calls)
+	}
+	if nsxCalls != 2 {
+		t.Errorf("Expected 2 NSX calls, got %d", nsxCalls)
+	}
+	for _, body := range bodies {
+		if body != "payload" {
+			t.Errorf("Expected request body to be replayed, got %q", body)
+		}
+	}
+
+	// Further requests use refreshed token without another exchange
+	req, _ = http.NewRequest("GET", nsxServer.URL, nil)
+	status = testVmcAuthRoundTrip(t, transport, req)
+	if status != http.StatusOK || authServer.calls != 2 || nsxCalls != 3 {
+		t.Errorf("Expected single call with refreshed token, got status %d, %d token exchanges, %d NSX calls", status, authServer.calls, nsxCalls)
+	}
+}
+
+func TestVmcAuthRetryTransportNonReplayableBody(t *testing.T) {
+	authServer := newTestVmcAuthServer(3600)
+	defer authServer.server.Close()
+	token := authServer.newToken(t)
+
+	validToken := "token-2"
+	var nsxCalls int32
+	nsxServer := newTestVmcNsxServer(&validToken, &nsxCalls, nil)
+	defer nsxServer.Close()
+
+	transport := &vmcAuthRetryTransport{
+		transport: nsxServer.Client().Transport,
+		processor: newVmcAuthHeaderProcessor(token, false),
+	}
+
+	req, _ := http.NewRequest("PUT", nsxServer.URL, ioutil.NopCloser(strings.NewReader("payload")))
+	status := testVmcAuthRoundTrip(t, transport, req)
+	if status != http.StatusUnauthorized {
+		t.Errorf("Expected status %d to be returned as is, got %d", http.StatusUnauthorized, status)
+	}
+	if authServer.calls != 1 || nsxCalls != 1 {
+		t.Errorf("Expected no retry, got %d token exchanges and %d NSX calls", authServer.calls, nsxCalls)
+	}
+}
+
+func TestVmcAuthTokenRefreshRejected(t *testing.T) {
+	authServer := newTestVmcAuthServer(3600)
+	defer authServer.server.Close()
+	token := authServer.newToken(t)
+
+	// Token already refreshed by concurrent request
+	err := token.refreshRejected("token-0")
+	if err != nil || authServer.calls != 1 {
+		t.Errorf("Expected no refresh for stale token, got %d token exchanges, error %v", authServer.calls, err)
+	}
+
+	err = token.refreshRejected("token-1")
+	if err != nil || authServer.calls != 2 || token.accessToken != "token-2" {
+		t.Errorf("Expected refresh to token-2, got %s after %d token exchanges, error %v", token.accessToken, authServer.calls, err)
+	}
+}
+
+func TestVmcAuthTokenRefreshBeforeExpiry(t *testing.T) {
+	cases := []struct {
+		name          string
+		expiresIn     int64
+		expectedCalls int32
+	}{
+		{"no expiry", 0, 1},
+		{"valid", 3600, 1},
+		{"within refresh margin", int64(vmcTokenRefreshMargin.Seconds()) - 10, 3},
+	}
+
+	for _, tc := range cases {
+		authServer := newTestVmcAuthServer(tc.expiresIn)
+		token := authServer.newToken(t)
+		for i := 0; i < 2; i++ {
+			_, err := token.getAccessToken()
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", tc.name, err)
+			}
+		}
+		if authServer.calls != tc.expectedCalls {
+			t.Errorf("%s: expected %d token exchanges, got %d", tc.name, tc.expectedCalls, authServer.calls)
+		}
+		authServer.server.Close()
+	}
+}
+
+func TestVmcAuthHeaderProcessor(t *testing.T) {
+	authServer := newTestVmcAuthServer(3600)
+	defer authServer.server.Close()
+	token := authServer.newToken(t)
+
+	for _, bearer := range []bool{true, false} {
+		processor := newVmcAuthHeaderProcessor(token, bearer)
+		req, _ := http.NewRequest("GET", "https://nsx", nil)
+		err := processor.Process(req)
+		if err != nil {
+			t.Fatalf("Failed to process request: %v", err)
+		}
+
+		if bearer && req.Header.Get("Authorization") != "Bearer token-1" {
+			t.Errorf("Expected bearer token, got headers %v", req.Header)
+		}
+		if !bearer && req.Header.Get(security.CSP_AUTH_TOKEN_KEY) != "token-1" {
+			t.Errorf("Expected CSP auth token, got headers %v", req.Header)
+		}
+		if processor.requestToken(req) != "token-1" {
+			t.Errorf("Expected request token token-1, got %s", processor.requestToken(req))
+		}
+	}
+}